@@ -7,6 +7,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/codesphere-cloud/cs-go/api/errors"
 	"github.com/codesphere-cloud/cs-go/api/openapi_client"
@@ -27,6 +28,14 @@ type Configuration struct {
 
 	// Verbose output for debugging
 	Verbose bool
+
+	// Maximum number of retries of requests failing with transient errors, see [RetryTransport].
+	// Defaults to DefaultMaxRetries, negative values disable retries.
+	MaxRetries int
+	// Backoff before the first retry, defaults to DefaultRetryBackoff
+	RetryBackoff time.Duration
+	// Maximum backoff between retries, defaults to DefaultMaxRetryBackoff
+	MaxRetryBackoff time.Duration
 }
 
 func (c Configuration) GetApiUrl() *url.URL {
@@ -49,9 +58,26 @@ func NewClientWithCustomDeps(ctx context.Context, opts Configuration, api *opena
 	}
 }
 
+// WithContext returns a copy of the client sending all requests with ctx,
+// e.g. to cancel them or bound them by a deadline.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	if token, ok := c.ctx.Value(openapi_client.ContextAccessToken).(string); ok {
+		ctx = context.WithValue(ctx, openapi_client.ContextAccessToken, token)
+	}
+	client := *c
+	client.ctx = ctx
+	return &client
+}
+
+// sleep waits for d, returning early with the context error if the client's context is done.
+func (c *Client) sleep(d time.Duration) error {
+	return sleepContext(c.ctx, c.time, d)
+}
+
 func NewClient(ctx context.Context, opts Configuration) *Client {
 	cfg := openapi_client.NewConfiguration()
 	cfg.HTTPClient = NewHttpClient()
+	cfg.HTTPClient.Transport = NewRetryTransport(http.DefaultTransport, opts)
 	cfg.Servers = []openapi_client.ServerConfiguration{{
 		URL: opts.BaseUrl.String(),
 	}}
@@ -5,9 +5,10 @@ package errors
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,6 +23,11 @@ func (e *TimedOutError) Error() string {
 	return e.msg
 }
 
+// Is reports TimedOutErrors as [ErrTimedOut].
+func (e *TimedOutError) Is(target error) bool {
+	return target == ErrTimedOut
+}
+
 func TimedOut(operation string, timeout time.Duration) *TimedOutError {
 	return &TimedOutError{
 		msg: fmt.Sprintf("%s timed out after %s", operation, timeout.String()),
@@ -36,6 +42,11 @@ func (e *NotFoundError) Error() string {
 	return e.msg
 }
 
+// Is reports NotFoundErrors as [ErrNotFound].
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
 func NotFound(msg string) *NotFoundError {
 	return &NotFoundError{
 		msg: msg,
@@ -56,6 +67,73 @@ func Duplicated(msg string) *DuplicatedError {
 	}
 }
 
+// ErrTimedOut matches [TimedOutError] and other errors of operations not finishing in time.
+var ErrTimedOut = errors.New("timed out")
+
+// Sentinel errors to check an [APIError] for its status code with errors.Is.
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrRateLimited  = errors.New("rate limited")
+	ErrServer       = errors.New("server error")
+)
+
+// APIError is returned by API calls failing with an error response or without a response at all.
+// Use errors.As to access the details or errors.Is to check against sentinel errors like [ErrNotFound].
+type APIError struct {
+	// HTTP status code, -1 if no response was received
+	StatusCode int
+	Title      string
+	Detail     string
+	TraceId    string
+	URL        string
+	// Original error of the API call
+	Err error
+}
+
+func (e *APIError) Error() string {
+	if e.Title == "" {
+		return fmt.Sprintf("unexpected error %d at URL %s: %s", e.StatusCode, e.URL, e.Err)
+	}
+	traceId := ""
+	if e.TraceId != "" {
+		traceId = fmt.Sprintf(" (trace ID: %s)", e.TraceId)
+	}
+	details := ""
+	if e.Detail != "" {
+		details = fmt.Sprintf(": %s", e.Detail)
+	}
+	return fmt.Sprintf("codesphere API returned error %d (%s)%s%s", e.StatusCode, e.Title, traceId, details)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Is matches sentinel errors by the status code.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= 500
+	}
+	return false
+}
+
 type APIErrorResponse struct {
 	Status  int    `json:"status"`
 	Title   string `json:"title"`
@@ -63,44 +141,68 @@ type APIErrorResponse struct {
 	TraceId string `json:"traceId"`
 }
 
+// responseBodyError is implemented by errors carrying the body of an error response,
+// like [openapi_client.GenericOpenAPIError] and [ResponseError].
+type responseBodyError interface {
+	Body() []byte
+}
+
+var _ responseBodyError = &openapi_client.GenericOpenAPIError{}
+
+// ResponseError is an error response to a request sent without the generated client.
+type ResponseError struct {
+	status string
+	body   []byte
+}
+
+func (e *ResponseError) Error() string {
+	return e.status
+}
+
+// Body returns the body of the error response.
+func (e *ResponseError) Body() []byte {
+	return e.body
+}
+
+func NewResponseError(status string, body []byte) *ResponseError {
+	return &ResponseError{
+		status: status,
+		body:   body,
+	}
+}
+
+// FormatAPIError converts errors of API calls to an [*APIError], parsing the error response if available.
 func FormatAPIError(r *http.Response, err error) error {
 	if err == nil {
 		return nil
 	}
 
-	if r == nil {
-		r = &http.Response{
-			StatusCode: -1,
+	apiErr := &APIError{StatusCode: -1, Err: err}
+	if r != nil {
+		apiErr.StatusCode = r.StatusCode
+		if r.Request != nil && r.Request.URL != nil {
+			apiErr.URL = r.Request.URL.String()
 		}
 	}
-	if r.Request == nil {
-		r.Request = &http.Request{URL: &url.URL{}}
-	}
 
-	openAPIErr, ok := err.(*openapi_client.GenericOpenAPIError)
+	bodyErr, ok := err.(responseBodyError)
 	if !ok {
-		return fmt.Errorf("unexpected error %d at URL %s: %w", r.StatusCode, r.Request.URL, err)
-	}
-
-	body := openAPIErr.Body()
-	if len(body) == 0 {
-		return fmt.Errorf("unexpected error %d at URL %s: %w", r.StatusCode, r.Request.URL, err)
+		return apiErr
 	}
 
-	var apiErr APIErrorResponse
-	if json.Unmarshal(body, &apiErr) != nil {
-		return fmt.Errorf("unexpected error %d at URL %s: %w", r.StatusCode, r.Request.URL, err)
+	var res APIErrorResponse
+	body := bodyErr.Body()
+	if len(body) == 0 || json.Unmarshal(body, &res) != nil {
+		return apiErr
 	}
 
-	traceId := ""
-	if apiErr.TraceId != "" {
-		traceId = fmt.Sprintf(" (trace ID: %s)", apiErr.TraceId)
+	if res.Status != 0 {
+		apiErr.StatusCode = res.Status
 	}
-	details := ""
-	if apiErr.Detail != "" {
-		details = fmt.Sprintf(": %s", apiErr.Detail)
-	}
-	return fmt.Errorf("codesphere API returned error %d (%s)%s%s", apiErr.Status, apiErr.Title, traceId, details)
+	apiErr.Title = res.Title
+	apiErr.Detail = res.Detail
+	apiErr.TraceId = res.TraceId
+	return apiErr
 }
 
 // IsRetryable returns true if the error is a server error (HTTP 500, 502, 503, 504).
@@ -108,6 +210,11 @@ func IsRetryable(err error) bool {
 	if err == nil {
 		return false
 	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode > 0 {
+		return slices.Contains([]int{500, 502, 503, 504}, apiErr.StatusCode)
+	}
+	// errors without response, e.g. formatted by other layers
 	msg := err.Error()
 	for _, code := range []string{"error 500", "error 502", "error 503", "error 504"} {
 		if strings.Contains(msg, code) {
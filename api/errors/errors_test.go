@@ -4,6 +4,7 @@
 package errors_test
 
 import (
+	goerrors "errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -58,4 +59,46 @@ var _ = Describe("FormatAPIError", func() {
 		Expect(res).ToNot(BeNil())
 		Expect(res.Error()).To(Equal("codesphere API returned error 400 (Workspace is not running) (trace ID: svJDMa5): Workspace '796636' is not in a running state."))
 	})
+
+	It("exposes the error response", func() {
+		apiErr := makeGenericOpenAPIError([]byte(`{"status":404,"title":"Not Found","detail":"Workspace not found","traceId":"abc"}`), "404 Not Found")
+		res := errors.FormatAPIError(r, apiErr)
+
+		var typed *errors.APIError
+		Expect(goerrors.As(res, &typed)).To(BeTrue())
+		Expect(typed.StatusCode).To(Equal(404))
+		Expect(typed.Title).To(Equal("Not Found"))
+		Expect(typed.Detail).To(Equal("Workspace not found"))
+		Expect(typed.TraceId).To(Equal("abc"))
+		Expect(typed.Unwrap()).To(Equal(apiErr))
+	})
+
+	It("matches sentinel errors by status code, also when wrapped", func() {
+		r.StatusCode = 401
+		res := fmt.Errorf("failed to list teams: %w", errors.FormatAPIError(r, fmt.Errorf("401 Unauthorized")))
+
+		Expect(goerrors.Is(res, errors.ErrUnauthorized)).To(BeTrue())
+		Expect(goerrors.Is(res, errors.ErrNotFound)).To(BeFalse())
+	})
+
+	It("has status -1 without response", func() {
+		res := errors.FormatAPIError(nil, fmt.Errorf("connection refused"))
+
+		var typed *errors.APIError
+		Expect(goerrors.As(res, &typed)).To(BeTrue())
+		Expect(typed.StatusCode).To(Equal(-1))
+	})
+})
+
+var _ = Describe("NotFound", func() {
+	It("matches ErrNotFound", func() {
+		Expect(goerrors.Is(errors.NotFound("no team with name x found"), errors.ErrNotFound)).To(BeTrue())
+	})
+})
+
+var _ = Describe("IsRetryable", func() {
+	It("returns true for server errors", func() {
+		Expect(errors.IsRetryable(&errors.APIError{StatusCode: 503})).To(BeTrue())
+		Expect(errors.IsRetryable(&errors.APIError{StatusCode: 409})).To(BeFalse())
+	})
 })
@@ -0,0 +1,70 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/codesphere-cloud/cs-go/api/errors"
+)
+
+func (c *Client) ListManagedServices(teamId int) ([]ManagedService, error) {
+	services, r, err := c.api.ManagedServicesAPI.ManagedServicesList(c.ctx).Team(teamId).Execute()
+	return services, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) GetManagedServiceDetails(serviceId string) (map[string]interface{}, error) {
+	details, r, err := c.api.ManagedServicesAPI.ManagedServicesGetDetails(c.ctx, serviceId).Execute()
+	return details, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) CreateManagedService(args CreateManagedServiceArgs) (*ManagedService, error) {
+	if args.Config == nil {
+		args.Config = map[string]interface{}{}
+	}
+	if args.Secrets == nil {
+		args.Secrets = map[string]interface{}{}
+	}
+	service, r, err := c.api.ManagedServicesAPI.ManagedServicesCreate(c.ctx).
+		ManagedServicesCreateRequest(args).
+		Execute()
+	return service, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) UpdateManagedService(serviceId string, args UpdateManagedServiceArgs) (*ManagedService, error) {
+	service, r, err := c.api.ManagedServicesAPI.ManagedServicesUpdate(c.ctx, serviceId).
+		ManagedServicesUpdateRequest(args).
+		Execute()
+	return service, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) DeleteManagedService(serviceId string) error {
+	r, err := c.api.ManagedServicesAPI.ManagedServicesDelete(c.ctx, serviceId).Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+// ScheduleManagedServiceBackup schedules a backup of the managed service.
+// The backup is taken asynchronously, the returned entry only contains the scheduled time.
+func (c *Client) ScheduleManagedServiceBackup(serviceId string) (*ManagedServiceBackup, error) {
+	backup, r, err := c.api.ManagedServicesAPI.ManagedServicesScheduleBackup(c.ctx, serviceId).Execute()
+	return backup, errors.FormatAPIError(r, err)
+}
+
+// ListManagedServiceProviders lists the providers available to the given team.
+// Pass a negative team ID to list globally available providers only.
+func (c *Client) ListManagedServiceProviders(teamId int) ([]ManagedServiceProvider, error) {
+	req := c.api.ManagedServicesAPI.ManagedServicesListProviders(c.ctx)
+	if teamId >= 0 {
+		req = req.TeamId(teamId)
+	}
+	providers, r, err := req.Execute()
+	if err != nil {
+		return nil, errors.FormatAPIError(r, err)
+	}
+	res := make([]ManagedServiceProvider, 0, len(providers))
+	for _, p := range providers {
+		if provider := ConvertToManagedServiceProvider(p); provider != nil {
+			res = append(res, *provider)
+		}
+	}
+	return res, nil
+}
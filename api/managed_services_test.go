@@ -0,0 +1,67 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+)
+
+var _ = Describe("ManagedServices", func() {
+	var (
+		msApiMock *openapi_client.MockManagedServicesAPI
+		client    *api.Client
+	)
+
+	BeforeEach(func() {
+		msApiMock = openapi_client.NewMockManagedServicesAPI(GinkgoT())
+		apis := openapi_client.APIClient{
+			ManagedServicesAPI: msApiMock,
+		}
+		client = api.NewClientWithCustomDeps(context.TODO(), api.Configuration{}, &apis, mockTime())
+	})
+
+	Context("ListManagedServiceProviders", func() {
+		It("flattens all provider variants", func() {
+			msApiMock.EXPECT().ManagedServicesListProviders(mock.Anything).
+				Return(openapi_client.ApiManagedServicesListProvidersRequest{ApiService: msApiMock})
+			msApiMock.EXPECT().ManagedServicesListProvidersExecute(mock.Anything).Return([]api.OpenAPIManagedServiceProvider{
+				{ManagedServicesListProviders200ResponseInnerAnyOf: &openapi_client.ManagedServicesListProviders200ResponseInnerAnyOf{
+					Name: "postgres", Version: "v1", DisplayName: "PostgreSQL",
+				}},
+				{ManagedServicesListProviders200ResponseInnerAnyOf1: &openapi_client.ManagedServicesListProviders200ResponseInnerAnyOf1{
+					Name: "redis", Version: "v2", DisplayName: "Redis",
+				}},
+			}, nil, nil)
+
+			providers, err := client.ListManagedServiceProviders(-1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(providers).To(Equal([]api.ManagedServiceProvider{
+				{Name: "postgres", Version: "v1", DisplayName: "PostgreSQL"},
+				{Name: "redis", Version: "v2", DisplayName: "Redis"},
+			}))
+		})
+	})
+
+	Context("ManagedServiceState", func() {
+		It("returns the state of any status variant", func() {
+			s := api.ManagedService{Status: openapi_client.ManagedServicesList200ResponseInnerStatus{
+				ManagedServicesList200ResponseInnerStatusAnyOf7: &openapi_client.ManagedServicesList200ResponseInnerStatusAnyOf7{
+					State: "failed", DetailsRef: "ref",
+				},
+			}}
+			Expect(api.ManagedServiceState(s)).To(Equal("failed"))
+		})
+
+		It("returns an empty state if the status is unset", func() {
+			Expect(api.ManagedServiceState(api.ManagedService{})).To(Equal(""))
+		})
+	})
+})
@@ -5,6 +5,7 @@
 package api
 
 import (
+	"context"
 	mock "github.com/stretchr/testify/mock"
 	"time"
 )
@@ -119,3 +120,87 @@ func (_c *MockTime_Sleep_Call) RunAndReturn(run func(duration time.Duration)) *M
 	_c.Run(run)
 	return _c
 }
+
+// newMockcontextSleeper creates a new instance of mockcontextSleeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockcontextSleeper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockcontextSleeper {
+	mock := &mockcontextSleeper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// mockcontextSleeper is an autogenerated mock type for the contextSleeper type
+type mockcontextSleeper struct {
+	mock.Mock
+}
+
+type mockcontextSleeper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *mockcontextSleeper) EXPECT() *mockcontextSleeper_Expecter {
+	return &mockcontextSleeper_Expecter{mock: &_m.Mock}
+}
+
+// SleepContext provides a mock function for the type mockcontextSleeper
+func (_mock *mockcontextSleeper) SleepContext(ctx context.Context, t time.Duration) error {
+	ret := _mock.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SleepContext")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
+		r0 = returnFunc(ctx, t)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// mockcontextSleeper_SleepContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SleepContext'
+type mockcontextSleeper_SleepContext_Call struct {
+	*mock.Call
+}
+
+// SleepContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - t time.Duration
+func (_e *mockcontextSleeper_Expecter) SleepContext(ctx any, t any) *mockcontextSleeper_SleepContext_Call {
+	return &mockcontextSleeper_SleepContext_Call{Call: _e.mock.On("SleepContext", ctx, t)}
+}
+
+func (_c *mockcontextSleeper_SleepContext_Call) Run(run func(ctx context.Context, t time.Duration)) *mockcontextSleeper_SleepContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *mockcontextSleeper_SleepContext_Call) Return(err error) *mockcontextSleeper_SleepContext_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *mockcontextSleeper_SleepContext_Call) RunAndReturn(run func(ctx context.Context, t time.Duration) error) *mockcontextSleeper_SleepContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -28,3 +28,28 @@ func (c *Client) CreateOrganization(name string, adminEmail string) (*Organizati
 
 	return org, nil
 }
+
+func (c *Client) ListOrgMembers(orgId string) ([]OrgMember, error) {
+	members, r, err := c.api.OrganizationsAPI.OrganizationsListOrgMembers(c.ctx, orgId).Execute()
+	if err != nil {
+		return nil, cserrors.FormatAPIError(r, err)
+	}
+	return members, nil
+}
+
+func (c *Client) AddOrgMember(orgId string, email string, role string) error {
+	req := openapi.NewOrganizationsAddOrgMemberRequest(email, role)
+	r, err := c.api.OrganizationsAPI.OrganizationsAddOrgMember(c.ctx, orgId).OrganizationsAddOrgMemberRequest(*req).Execute()
+	return cserrors.FormatAPIError(r, err)
+}
+
+func (c *Client) ChangeOrgMemberRole(orgId string, userId int, role string) error {
+	req := openapi.NewOrganizationsChangeOrgRoleRequest(role)
+	r, err := c.api.OrganizationsAPI.OrganizationsChangeOrgRole(c.ctx, orgId, userId).OrganizationsChangeOrgRoleRequest(*req).Execute()
+	return cserrors.FormatAPIError(r, err)
+}
+
+func (c *Client) RemoveOrgMember(orgId string, userId int) error {
+	r, err := c.api.OrganizationsAPI.OrganizationsRemoveOrgMember(c.ctx, orgId, userId).Execute()
+	return cserrors.FormatAPIError(r, err)
+}
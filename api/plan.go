@@ -11,7 +11,7 @@ import (
 
 // Fetches the workspace plan for a given name.
 //
-// Returns [NotFound] if no plan with the given Id could be found
+// Returns [NotFound] if no plan with the given name could be found
 func (client *Client) PlanByName(name string) (WorkspacePlan, error) {
 	plans, err := client.ListWorkspacePlans()
 	if err != nil {
@@ -23,7 +23,7 @@ func (client *Client) PlanByName(name string) (WorkspacePlan, error) {
 			return p, nil
 		}
 	}
-	return WorkspacePlan{}, cserrors.NotFound(fmt.Sprintf("no team with name %s found", name))
+	return WorkspacePlan{}, cserrors.NotFound(fmt.Sprintf("no plan with name %s found", name))
 }
 
 func (c *Client) ListWorkspacePlans() ([]WorkspacePlan, error) {
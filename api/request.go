@@ -0,0 +1,79 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+)
+
+// Request sends an authenticated request to an arbitrary API endpoint, e.g. one not wrapped by the client yet.
+// The path is relative to the API URL, the body is sent as JSON if not nil.
+//
+// Returns the response body, or an [*errors.APIError] for responses with a status code outside 2xx.
+func (c *Client) Request(method string, path string, query url.Values, body []byte) ([]byte, error) {
+	cfg := c.api.GetConfig()
+	if cfg == nil || len(cfg.Servers) == 0 {
+		return nil, fmt.Errorf("API client is not configured")
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Servers[0].URL, "/") + "/" + strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %s: %w", path, err)
+	}
+	if len(query) > 0 {
+		q := endpoint.Query()
+		for key, values := range query {
+			for _, v := range values {
+				q.Add(key, v)
+			}
+		}
+		endpoint.RawQuery = q.Encode()
+	}
+
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(c.ctx, strings.ToUpper(method), endpoint.String(), reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token, ok := c.ctx.Value(openapi_client.ContextAccessToken).(string); ok {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", cfg.UserAgent)
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	r, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.FormatAPIError(nil, err)
+	}
+	defer func() { _ = r.Body.Close() }()
+
+	resBody, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if r.StatusCode < 200 || r.StatusCode >= 300 {
+		return resBody, errors.FormatAPIError(r, errors.NewResponseError(r.Status, resBody))
+	}
+	return resBody, nil
+}
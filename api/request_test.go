@@ -0,0 +1,67 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/api/errors"
+)
+
+var _ = Describe("Request", func() {
+	var (
+		server  *httptest.Server
+		handler http.HandlerFunc
+		client  *api.Client
+	)
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		baseUrl, err := url.Parse(server.URL + "/api")
+		Expect(err).NotTo(HaveOccurred())
+		client = api.NewClient(context.Background(), api.Configuration{BaseUrl: baseUrl, Token: "token", MaxRetries: -1})
+	})
+
+	It("sends authenticated requests relative to the API URL", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/api/teams"))
+			Expect(r.URL.Query().Get("x")).To(Equal("y"))
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			body, _ := io.ReadAll(r.Body)
+			Expect(body).To(MatchJSON(`{"name":"a"}`))
+			_, _ = w.Write([]byte(`{"id":1}`))
+		}
+
+		res, err := client.Request("post", "teams", url.Values{"x": {"y"}}, []byte(`{"name":"a"}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(MatchJSON(`{"id":1}`))
+	})
+
+	It("returns error responses as APIError", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"status":404,"title":"Not Found","detail":"team 1 not found","traceId":"abc"}`))
+		}
+
+		res, err := client.Request("GET", "/teams/1", nil, nil)
+		Expect(err).To(MatchError(errors.ErrNotFound))
+		Expect(err).To(MatchError("codesphere API returned error 404 (Not Found) (trace ID: abc): team 1 not found"))
+		Expect(res).To(ContainSubstring("team 1 not found"))
+	})
+})
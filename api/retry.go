@@ -0,0 +1,160 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries      = 3
+	DefaultRetryBackoff    = 500 * time.Millisecond
+	DefaultMaxRetryBackoff = 30 * time.Second
+)
+
+// idempotentMethods can be retried on server errors, as repeating them doesn't change the result.
+var idempotentMethods = []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete}
+
+// RetryTransport retries requests failing with transient errors.
+//
+// Rate limited requests (429) are retried for all methods as the server didn't process them.
+// Requests with idempotent methods are also retried on network errors and on 502, 503 and 504 responses.
+// The Retry-After header is honored, otherwise the backoff grows exponentially with jitter.
+type RetryTransport struct {
+	Base http.RoundTripper
+	// Maximum number of retries per request
+	MaxRetries int
+	// Backoff before the first retry, doubled for each further retry
+	Backoff time.Duration
+	// Maximum backoff between retries, also limiting waits requested by Retry-After
+	MaxBackoff time.Duration
+	// Log retries
+	Verbose bool
+
+	Time Time
+	// Jitter returns a random factor in [0, 1) to spread retries of concurrent clients
+	Jitter func() float64
+}
+
+// NewRetryTransport creates a retrying transport for base with the retry limits of the configuration.
+func NewRetryTransport(base http.RoundTripper, opts Configuration) *RetryTransport {
+	t := &RetryTransport{
+		Base:       base,
+		MaxRetries: opts.MaxRetries,
+		Backoff:    opts.RetryBackoff,
+		MaxBackoff: opts.MaxRetryBackoff,
+		Verbose:    opts.Verbose,
+		Time:       &RealTime{},
+		Jitter:     rand.Float64,
+	}
+	if t.MaxRetries == 0 {
+		t.MaxRetries = DefaultMaxRetries
+	}
+	if t.Backoff <= 0 {
+		t.Backoff = DefaultRetryBackoff
+	}
+	if t.MaxBackoff <= 0 {
+		t.MaxBackoff = DefaultMaxRetryBackoff
+	}
+	return t
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for retry := 0; ; retry++ {
+		res, err := t.Base.RoundTrip(req)
+		if retry >= t.MaxRetries || !t.shouldRetry(req, res, err) {
+			if retry > 0 && t.Verbose {
+				log.Printf("%s %s: %s after %d retries\n", req.Method, req.URL.Redacted(), outcome(res, err), retry)
+			}
+			return res, err
+		}
+
+		// the body of the retried request must be rewound
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return res, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return res, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		wait := t.backoff(retry, res)
+		if t.Verbose {
+			log.Printf("%s %s: %s, retry %d/%d in %s\n", req.Method, req.URL.Redacted(), outcome(res, err), retry+1, t.MaxRetries, wait.Round(time.Millisecond))
+		}
+		if res != nil {
+			_ = res.Body.Close()
+		}
+
+		if sleepErr := sleepContext(req.Context(), t.Time, wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+func (t *RetryTransport) shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !slices.Contains(idempotentMethods, req.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the wait time before the next retry, preferring the Retry-After header of the response.
+func (t *RetryTransport) backoff(retry int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := t.retryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, t.MaxBackoff)
+		}
+	}
+
+	wait := t.Backoff << retry
+	if wait <= 0 || wait > t.MaxBackoff {
+		wait = t.MaxBackoff
+	}
+	// randomize the upper half of the backoff
+	return wait/2 + time.Duration(t.Jitter()*float64(wait/2))
+}
+
+// retryAfter parses a Retry-After header in seconds or as HTTP date.
+func (t *RetryTransport) retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.Sub(t.Time.Now()), 0), true
+	}
+	return 0, false
+}
+
+func outcome(res *http.Response, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return res.Status
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/codesphere-cloud/cs-go/api"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func response(status int, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{StatusCode: status, Status: http.StatusText(status), Header: header, Body: io.NopCloser(strings.NewReader(""))}
+}
+
+var _ = Describe("RetryTransport", func() {
+	var (
+		responses []*http.Response
+		bodies    []string
+		sleeps    []time.Duration
+		transport *api.RetryTransport
+	)
+
+	BeforeEach(func() {
+		responses = nil
+		bodies = nil
+		sleeps = nil
+		m := api.NewMockTime(GinkgoT())
+		m.EXPECT().Sleep(mock.Anything).Run(func(d time.Duration) { sleeps = append(sleeps, d) }).Maybe()
+		m.EXPECT().Now().Return(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)).Maybe()
+
+		transport = api.NewRetryTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if req.Body != nil {
+				body, _ := io.ReadAll(req.Body)
+				bodies = append(bodies, string(body))
+			}
+			res := responses[0]
+			responses = responses[1:]
+			if res == nil {
+				return nil, errors.New("connection reset by peer")
+			}
+			return res, nil
+		}), api.Configuration{MaxRetries: 2, RetryBackoff: time.Second, MaxRetryBackoff: 10 * time.Second})
+		transport.Time = m
+		transport.Jitter = func() float64 { return 0.5 }
+	})
+
+	It("retries idempotent requests on server errors with exponential backoff", func() {
+		responses = []*http.Response{response(502, nil), nil, response(200, nil)}
+		req, _ := http.NewRequest(http.MethodGet, "https://cloud.codesphere.com/api/teams", nil)
+
+		res, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(200))
+		Expect(sleeps).To(Equal([]time.Duration{750 * time.Millisecond, 1500 * time.Millisecond}))
+	})
+
+	It("gives up after the maximum number of retries", func() {
+		responses = []*http.Response{response(503, nil), response(503, nil), response(503, nil)}
+		req, _ := http.NewRequest(http.MethodGet, "https://cloud.codesphere.com/api/teams", nil)
+
+		res, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(503))
+		Expect(sleeps).To(HaveLen(2))
+	})
+
+	It("doesn't retry non-idempotent requests on server errors", func() {
+		responses = []*http.Response{response(502, nil)}
+		req, _ := http.NewRequest(http.MethodPost, "https://cloud.codesphere.com/api/teams", strings.NewReader("{}"))
+
+		res, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(502))
+		Expect(sleeps).To(BeEmpty())
+	})
+
+	It("retries rate limited requests honoring Retry-After and resends the body", func() {
+		responses = []*http.Response{response(429, http.Header{"Retry-After": []string{"3"}}), response(201, nil)}
+		req, _ := http.NewRequest(http.MethodPost, "https://cloud.codesphere.com/api/teams", strings.NewReader(`{"name":"team"}`))
+
+		res, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(201))
+		Expect(sleeps).To(Equal([]time.Duration{3 * time.Second}))
+		Expect(bodies).To(Equal([]string{`{"name":"team"}`, `{"name":"team"}`}))
+	})
+
+	It("limits waits requested by Retry-After dates", func() {
+		header := http.Header{"Retry-After": []string{"Wed, 01 Jan 2025 13:00:00 GMT"}}
+		responses = []*http.Response{response(429, header), response(200, nil)}
+		req, _ := http.NewRequest(http.MethodGet, "https://cloud.codesphere.com/api/teams", nil)
+
+		_, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sleeps).To(Equal([]time.Duration{10 * time.Second}))
+	})
+
+	It("doesn't retry if disabled", func() {
+		transport.MaxRetries = -1
+		responses = []*http.Response{response(429, nil)}
+		req, _ := http.NewRequest(http.MethodGet, "https://cloud.codesphere.com/api/teams", nil)
+
+		res, err := transport.RoundTrip(req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.StatusCode).To(Equal(429))
+		Expect(sleeps).To(BeEmpty())
+	})
+})
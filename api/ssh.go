@@ -0,0 +1,30 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+)
+
+// ListSshKeys lists the public SSH keys of the authenticated user.
+func (c *Client) ListSshKeys() ([]SshKey, error) {
+	keys, r, err := c.api.SshAPI.SshListPublicKeys(c.ctx).Execute()
+	return keys, errors.FormatAPIError(r, err)
+}
+
+// AddSshKey uploads a public SSH key in authorized_keys format for the authenticated user.
+func (c *Client) AddSshKey(publicKey string) error {
+	r, err := c.api.SshAPI.SshUploadPublicKey(c.ctx).
+		SshUploadPublicKeyRequest(openapi_client.SshUploadPublicKeyRequest{PublicKey: publicKey}).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+func (c *Client) DeleteSshKey(fingerprint string) error {
+	r, err := c.api.SshAPI.SshDeletePublicKey(c.ctx).
+		SshDeletePublicKeyRequest(openapi_client.SshDeletePublicKeyRequest{PublicKeyFingerprint: fingerprint}).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
@@ -127,6 +127,20 @@ func (c *Client) AddTeamMember(teamId int, email string, role int) error {
 	return nil
 }
 
+// ChangeTeamMemberRole changes the role of a team member.
+// The role uses the same values as [Client.AddTeamMember], i.e. 1 for member and -1 for admin.
+func (c *Client) ChangeTeamMemberRole(teamId int, userId int, role int) error {
+	// The change role endpoint expects 0 instead of -1 for admins
+	if role == -1 {
+		role = 0
+	}
+	r, err := c.api.TeamsAPI.TeamsChangeRole(c.ctx, teamId, userId).
+		TeamsChangeRoleRequest(openapi_client.TeamsChangeRoleRequest{
+			Role: role,
+		}).Execute()
+	return cserrors.FormatAPIError(r, err)
+}
+
 func (c *Client) RemoveTeamMember(teamId int, userId int) error {
 	r, err := c.api.TeamsAPI.TeamsRemoveMember(c.ctx, teamId, userId).Execute()
 	if err != nil {
@@ -135,6 +149,17 @@ func (c *Client) RemoveTeamMember(teamId int, userId int) error {
 	return nil
 }
 
+// MigrateTeamToOrg moves a standalone team into an organization.
+// With force, team members not belonging to the organization are removed from the team.
+func (c *Client) MigrateTeamToOrg(teamId int, orgId string, force bool) error {
+	r, err := c.api.TeamsAPI.TeamsMigrateTeamToOrg(c.ctx, teamId).
+		TeamsMigrateTeamToOrgRequest(openapi_client.TeamsMigrateTeamToOrgRequest{
+			OrganizationId: &orgId,
+			Force:          &force,
+		}).Execute()
+	return cserrors.FormatAPIError(r, err)
+}
+
 func (c *Client) ListTeamMembers(teamId int) ([]TeamMember, error) {
 	members, r, err := c.api.TeamsAPI.TeamsListMembers(c.ctx, teamId).Execute()
 	if err != nil {
@@ -4,6 +4,8 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
 	"time"
 
 	openapi "github.com/codesphere-cloud/cs-go/api/openapi_client"
@@ -31,13 +33,37 @@ type DomainVerificationStatus = openapi.DomainsGetDomain200ResponseDomainVerific
 type UpdateDomainArgs = openapi.DomainsUpdateDomainRequest
 type PathToWorkspaces = map[string][]*Workspace
 type Organization = openapi.ClustersListAllOrganizations200ResponseInner
+type OrgMember = openapi.OrganizationsListOrgMembers200ResponseInner
 type Workspace = openapi.WorkspacesGetWorkspace200Response
 type Baseimage = openapi.MetadataGetWorkspaceBaseImages200ResponseInner
 type WorkspaceStatus = openapi.WorkspacesGetWorkspaceStatus200Response
+type EnvVar = openapi.WorkspacesCreateWorkspaceRequestEnvInner
 type CreateWorkspaceArgs = openapi.WorkspacesCreateWorkspaceRequest
+type UpdateWorkspaceArgs = openapi.WorkspacesUpdateWorkspaceRequest
 type WorkspacePlan = openapi.MetadataGetWorkspacePlans200ResponseInner
 
 type PipelineStatus = openapi.WorkspacesPipelineStatus200ResponseInner
+type SshKey = openapi.SshListPublicKeys200ResponseInner
+
+type ManagedService = openapi.ManagedServicesList200ResponseInner
+type ManagedServicePlan = openapi.ManagedServicesList200ResponseInnerPlan
+type ManagedServiceBackup = openapi.ManagedServicesList200ResponseInnerBackupsAnyOfEntriesInner
+type CreateManagedServiceArgs = openapi.ManagedServicesCreateRequest
+type UpdateManagedServiceArgs = openapi.ManagedServicesUpdateRequest
+type OpenAPIManagedServiceProvider = openapi.ManagedServicesListProviders200ResponseInner
+type ManagedServiceProviderPlan = openapi.ManagedServicesListProviders200ResponseInnerAnyOfPlansInner
+
+type ManagedServiceProvider struct {
+	Name          string                       `json:"name"`
+	Version       string                       `json:"version"`
+	SchemaVersion string                       `json:"schemaVersion"`
+	DisplayName   string                       `json:"displayName"`
+	Category      string                       `json:"category"`
+	Description   string                       `json:"description"`
+	Author        string                       `json:"author"`
+	Scope         *string                      `json:"scope,omitempty"`
+	Plans         []ManagedServiceProviderPlan `json:"plans"`
+}
 
 type TeamMember struct {
 	UserId    int        `json:"userId"`
@@ -116,6 +142,54 @@ func ConvertToTeamMember(t OpenAPITeamMember) TeamMember {
 	}
 }
 
+// ConvertToManagedServiceProvider flattens the provider variants returned by the API.
+// Returns nil if the response matched none of the known variants.
+func ConvertToManagedServiceProvider(p OpenAPIManagedServiceProvider) *ManagedServiceProvider {
+	if v := p.ManagedServicesListProviders200ResponseInnerAnyOf; v != nil {
+		return &ManagedServiceProvider{
+			Name:          v.Name,
+			Version:       v.Version,
+			SchemaVersion: v.SchemaVersion,
+			DisplayName:   v.DisplayName,
+			Category:      v.Category,
+			Description:   v.Description,
+			Author:        v.Author,
+			Scope:         v.Scope,
+			Plans:         v.Plans,
+		}
+	}
+	if v := p.ManagedServicesListProviders200ResponseInnerAnyOf1; v != nil {
+		return &ManagedServiceProvider{
+			Name:          v.Name,
+			Version:       v.Version,
+			SchemaVersion: v.SchemaVersion,
+			DisplayName:   v.DisplayName,
+			Category:      v.Category,
+			Description:   v.Description,
+			Author:        v.Author,
+			Scope:         v.Scope,
+			Plans:         v.Plans,
+		}
+	}
+	return nil
+}
+
+// ManagedServiceState returns the state of a managed service, e.g. "running".
+// All status variants of the API share the state field, hence it is read from the JSON representation.
+func ManagedServiceState(s ManagedService) string {
+	raw, err := s.Status.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+	status := struct {
+		State string `json:"state"`
+	}{}
+	if err := json.Unmarshal(raw, &status); err != nil {
+		return ""
+	}
+	return status.State
+}
+
 type Time interface {
 	Sleep(time.Duration)
 	Now() time.Time
@@ -130,3 +204,29 @@ func (r *RealTime) Now() time.Time {
 func (r *RealTime) Sleep(t time.Duration) {
 	time.Sleep(t)
 }
+
+// SleepContext sleeps for t or until ctx is done.
+func (r *RealTime) SleepContext(ctx context.Context, t time.Duration) error {
+	timer := time.NewTimer(t)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// contextSleeper is implemented by [Time] implementations which can stop sleeping when a context is done.
+type contextSleeper interface {
+	SleepContext(ctx context.Context, t time.Duration) error
+}
+
+// sleepContext sleeps for d, aborting early if ctx is done and the clock supports it.
+func sleepContext(ctx context.Context, clock Time, d time.Duration) error {
+	if s, ok := clock.(contextSleeper); ok {
+		return s.SleepContext(ctx, d)
+	}
+	clock.Sleep(d)
+	return ctx.Err()
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+)
+
+// ListWorkspaceSecrets returns the keys of all secrets stored for the workspace.
+// Secret values are never returned by the API.
+func (c *Client) ListWorkspaceSecrets(teamId int, workspaceId int) ([]string, error) {
+	keys, r, err := c.api.VaultAPI.VaultListWorkspaceSecrets(c.ctx, teamId, workspaceId).Execute()
+	return keys, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) StoreWorkspaceSecrets(teamId int, workspaceId int, secrets map[string]string) error {
+	_, r, err := c.api.VaultAPI.VaultStoreWorkspaceSecrets(c.ctx, teamId, workspaceId).
+		Body(toSecretsBody(secrets)).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+func (c *Client) DeleteWorkspaceSecrets(teamId int, workspaceId int, keys []string) error {
+	r, err := c.api.VaultAPI.VaultDeleteWorkspaceSecrets(c.ctx, teamId, workspaceId).
+		RequestBody(keys).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+// GenerateWorkspaceSecrets generates random secrets for the workspace.
+// The policies map contains secret key -> password policy.
+func (c *Client) GenerateWorkspaceSecrets(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error) {
+	res, r, err := c.api.VaultAPI.VaultGenerateSecrets(c.ctx, teamId, workspaceId).
+		Body(policies).
+		Execute()
+	return res, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) ListSharedVaults(teamId int) ([]string, error) {
+	vaults, r, err := c.api.VaultAPI.VaultListSharedVaults(c.ctx, teamId).Execute()
+	return vaults, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) CreateSharedVault(teamId int, name string) error {
+	r, err := c.api.VaultAPI.VaultCreateSharedVault(c.ctx, teamId).
+		VaultCreateSharedVaultRequest(openapi_client.VaultCreateSharedVaultRequest{Name: name}).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+func (c *Client) DeleteSharedVault(teamId int, name string) error {
+	r, err := c.api.VaultAPI.VaultDeleteSharedVault(c.ctx, teamId, name).Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+// ListSharedSecrets returns the keys of all secrets stored in the shared vault.
+// Secret values are never returned by the API.
+func (c *Client) ListSharedSecrets(teamId int, vaultName string) ([]string, error) {
+	keys, r, err := c.api.VaultAPI.VaultListSharedSecretKeys(c.ctx, teamId, vaultName).Execute()
+	return keys, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) StoreSharedSecrets(teamId int, vaultName string, secrets map[string]string) error {
+	_, r, err := c.api.VaultAPI.VaultStoreSharedSecrets(c.ctx, teamId, vaultName).
+		Body(toSecretsBody(secrets)).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+func (c *Client) DeleteSharedSecrets(teamId int, vaultName string, keys []string) error {
+	r, err := c.api.VaultAPI.VaultDeleteSharedSecrets(c.ctx, teamId, vaultName).
+		RequestBody(keys).
+		Execute()
+	return errors.FormatAPIError(r, err)
+}
+
+// GenerateSharedSecrets generates random secrets in the shared vault.
+// The policies map contains secret key -> password policy.
+func (c *Client) GenerateSharedSecrets(teamId int, vaultName string, policies map[string]interface{}) (map[string]interface{}, error) {
+	res, r, err := c.api.VaultAPI.VaultGenerateSharedSecrets(c.ctx, teamId, vaultName).
+		Body(policies).
+		Execute()
+	return res, errors.FormatAPIError(r, err)
+}
+
+func toSecretsBody(secrets map[string]string) map[string]interface{} {
+	body := make(map[string]interface{}, len(secrets))
+	for k, v := range secrets {
+		body[k] = v
+	}
+	return body
+}
@@ -4,6 +4,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/codesphere-cloud/cs-go/api/errors"
@@ -56,8 +57,23 @@ func (c *Client) SetEnvVarOnWorkspace(workspaceId int, envVars map[string]string
 	return errors.FormatAPIError(r, err)
 }
 
+func (c *Client) ListEnvVars(workspaceId int) ([]EnvVar, error) {
+	vars, r, err := c.api.WorkspacesAPI.WorkspacesListEnvVars(c.ctx, workspaceId).Execute()
+	return vars, errors.FormatAPIError(r, err)
+}
+
+func (c *Client) DeleteEnvVars(workspaceId int, names []string) error {
+	r, err := c.api.WorkspacesAPI.WorkspacesDeleteEnvVar(c.ctx, workspaceId).RequestBody(names).Execute()
+	return errors.FormatAPIError(r, err)
+}
+
 func (c *Client) ExecCommand(workspaceId int, command string, workdir string, env map[string]string) (string, string, error) {
+	return c.ExecCommandWithContext(c.ctx, workspaceId, command, workdir, env)
+}
 
+// ExecCommandWithContext is like [Client.ExecCommand], cancelling the command execution when ctx is done.
+func (c *Client) ExecCommandWithContext(ctx context.Context, workspaceId int, command string, workdir string, env map[string]string) (string, string, error) {
+	c = c.WithContext(ctx)
 	workdirP := &workdir
 	if workdir == "" {
 		workdirP = nil
@@ -91,6 +107,11 @@ func (c *Client) DeployLandscape(wsId int, profile string) error {
 	return errors.FormatAPIError(r, err)
 }
 
+func (c *Client) TeardownLandscape(wsId int) error {
+	r, err := c.api.WorkspacesAPI.WorkspacesTeardownLandscape(c.ctx, wsId).Execute()
+	return errors.FormatAPIError(r, err)
+}
+
 func (c *Client) StartPipelineStage(wsId int, profile string, stage string) error {
 	if profile == "ci.yml" || profile == "" {
 		req := c.api.WorkspacesAPI.WorkspacesStartPipelineStage(c.ctx, wsId, stage)
@@ -110,6 +131,12 @@ func (c *Client) StopPipelineStage(wsId int, stage string) error {
 }
 
 func (c *Client) GetPipelineState(wsId int, stage string) ([]PipelineStatus, error) {
+	return c.GetPipelineStateWithContext(c.ctx, wsId, stage)
+}
+
+// GetPipelineStateWithContext is like [Client.GetPipelineState], using ctx for the request.
+func (c *Client) GetPipelineStateWithContext(ctx context.Context, wsId int, stage string) ([]PipelineStatus, error) {
+	c = c.WithContext(ctx)
 	req := c.api.WorkspacesAPI.WorkspacesPipelineStatus(c.ctx, wsId, stage)
 	res, r, err := req.Execute()
 	return res, errors.FormatAPIError(r, err)
@@ -118,10 +145,16 @@ func (c *Client) GetPipelineState(wsId int, stage string) ([]PipelineStatus, err
 // ScaleWorkspace sets the number of replicas for a workspace.
 // For on-demand workspaces, setting replicas to 1 wakes up the workspace.
 func (c *Client) ScaleWorkspace(wsId int, replicas int) error {
+	return c.UpdateWorkspace(wsId, UpdateWorkspaceArgs{
+		Replicas: &replicas,
+	})
+}
+
+// UpdateWorkspace changes the settings of a workspace.
+// Only fields set in args are changed, all other settings are kept.
+func (c *Client) UpdateWorkspace(wsId int, args UpdateWorkspaceArgs) error {
 	req := c.api.WorkspacesAPI.WorkspacesUpdateWorkspace(c.ctx, wsId).
-		WorkspacesUpdateWorkspaceRequest(openapi_client.WorkspacesUpdateWorkspaceRequest{
-			Replicas: &replicas,
-		})
+		WorkspacesUpdateWorkspaceRequest(args)
 	r, err := req.Execute()
 	return errors.FormatAPIError(r, err)
 }
@@ -139,6 +172,14 @@ func (c *Client) ScaleLandscapeServices(wsId int, services map[string]int) error
 //
 // Returns [TimedOut] error if the workspace does not become running in time.
 func (client *Client) WaitForWorkspaceRunning(workspace *Workspace, timeout time.Duration) error {
+	return client.WaitForWorkspaceRunningWithContext(client.ctx, workspace, timeout)
+}
+
+// WaitForWorkspaceRunningWithContext is like [Client.WaitForWorkspaceRunning], stopping to wait when ctx is done.
+//
+// Returns the context error if ctx is done before the workspace is running.
+func (client *Client) WaitForWorkspaceRunningWithContext(ctx context.Context, workspace *Workspace, timeout time.Duration) error {
+	client = client.WithContext(ctx)
 	delay := 5 * time.Second
 
 	maxWaitTime := client.time.Now().Add(timeout)
@@ -149,7 +190,9 @@ func (client *Client) WaitForWorkspaceRunning(workspace *Workspace, timeout time
 			if client.time.Now().After(maxWaitTime) {
 				return err
 			}
-			client.time.Sleep(delay)
+			if err := client.sleep(delay); err != nil {
+				return err
+			}
 			continue
 		}
 		if status.IsRunning {
@@ -158,7 +201,9 @@ func (client *Client) WaitForWorkspaceRunning(workspace *Workspace, timeout time
 		if client.time.Now().After(maxWaitTime) {
 			break
 		}
-		client.time.Sleep(delay)
+		if err := client.sleep(delay); err != nil {
+			return err
+		}
 	}
 
 	return errors.TimedOut(
@@ -178,6 +223,10 @@ type DeployWorkspaceArgs struct {
 	Branch        *string //must be nil to use default
 	BaseImage     *string //must be nil to use default
 	Restricted    *bool   //must be nil to use default
+	GitRef        *string //must be nil to use default
+	CloneDepth    *int    //must be nil to clone the full history
+
+	SourceWorkspaceId *int //must be nil to create a new workspace instead of cloning
 
 	Timeout time.Duration
 }
@@ -186,6 +235,12 @@ type DeployWorkspaceArgs struct {
 //
 // Returns [TimedOut] error if the timeout is reached
 func (client Client) DeployWorkspace(args DeployWorkspaceArgs) (*Workspace, error) {
+	return client.DeployWorkspaceWithContext(client.ctx, args)
+}
+
+// DeployWorkspaceWithContext is like [Client.DeployWorkspace], stopping to wait when ctx is done.
+func (client Client) DeployWorkspaceWithContext(ctx context.Context, args DeployWorkspaceArgs) (*Workspace, error) {
+	c := client.WithContext(ctx)
 	createArgs := CreateWorkspaceArgs{
 		TeamId:            args.TeamId,
 		Name:              args.Name,
@@ -195,7 +250,9 @@ func (client Client) DeployWorkspace(args DeployWorkspaceArgs) (*Workspace, erro
 		InitialBranch:     args.Branch,
 		BaseImage:         args.BaseImage,
 		Restricted:        args.Restricted,
-		SourceWorkspaceId: nil,
+		GitRef:            args.GitRef,
+		CloneDepth:        args.CloneDepth,
+		SourceWorkspaceId: args.SourceWorkspaceId,
 		WelcomeMessage:    nil,
 		Replicas:          1,
 		VpnConfig:         args.VpnConfigName,
@@ -204,24 +261,26 @@ func (client Client) DeployWorkspace(args DeployWorkspaceArgs) (*Workspace, erro
 	var workspace *Workspace
 	var err error
 	for attempt := 0; attempt < 5; attempt++ {
-		workspace, err = client.CreateWorkspace(createArgs)
+		workspace, err = c.CreateWorkspace(createArgs)
 		if err == nil {
 			break
 		}
 		if !errors.IsRetryable(err) {
 			return nil, err
 		}
-		client.time.Sleep(time.Duration(attempt+1) * 5 * time.Second)
+		if err := c.sleep(time.Duration(attempt+1) * 5 * time.Second); err != nil {
+			return nil, err
+		}
 	}
 	if err != nil {
 		return nil, err
 	}
-	if err := client.WaitForWorkspaceRunning(workspace, args.Timeout); err != nil {
+	if err := c.WaitForWorkspaceRunning(workspace, args.Timeout); err != nil {
 		return workspace, err
 	}
 
 	if len(args.EnvVars) != 0 {
-		if err := client.SetEnvVarOnWorkspace(workspace.Id, args.EnvVars); err != nil {
+		if err := c.SetEnvVarOnWorkspace(workspace.Id, args.EnvVars); err != nil {
 			return workspace, err
 		}
 	}
@@ -29,6 +29,8 @@ func mockTime() *api.MockTime {
 	return m
 }
 
+type contextKey string
+
 func mockWorkspaceStatus(wsApiMock *openapi_client.MockWorkspacesAPI, workspaceId int, isRunning ...bool) {
 	wsApiMock.EXPECT().WorkspacesGetWorkspaceStatus(mock.Anything, workspaceId).
 		Return(openapi_client.ApiWorkspacesGetWorkspaceStatusRequest{ApiService: wsApiMock})
@@ -102,6 +104,35 @@ var _ = Describe("Workspace", func() {
 
 			Expect(err).NotTo(HaveOccurred())
 		})
+		It("Stops waiting when the context is cancelled", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			wsApiMock.EXPECT().WorkspacesGetWorkspaceStatus(mock.Anything, ws.Id).
+				Return(openapi_client.ApiWorkspacesGetWorkspaceStatusRequest{ApiService: wsApiMock})
+			wsApiMock.EXPECT().WorkspacesGetWorkspaceStatusExecute(mock.Anything).
+				Run(func(openapi_client.ApiWorkspacesGetWorkspaceStatusRequest) { cancel() }).
+				Return(&api.WorkspaceStatus{IsRunning: false}, nil, nil).Once()
+
+			err := client.WaitForWorkspaceRunningWithContext(ctx, &ws, 1*time.Minute)
+			Expect(err).To(MatchError(context.Canceled))
+		})
+	})
+
+	Context("WithContext", func() {
+		It("sends requests with the given context and the access token", func() {
+			client = api.NewClientWithCustomDeps(context.TODO(), api.Configuration{Token: "token"}, &openapi_client.APIClient{WorkspacesAPI: wsApiMock}, mockTime())
+			ctx := context.WithValue(context.Background(), contextKey("request"), "42")
+
+			wsApiMock.EXPECT().WorkspacesPipelineStatus(mock.Anything, 1, "run").RunAndReturn(
+				func(reqCtx context.Context, wsId int, stage string) openapi_client.ApiWorkspacesPipelineStatusRequest {
+					Expect(reqCtx.Value(contextKey("request"))).To(Equal("42"))
+					Expect(reqCtx.Value(openapi_client.ContextAccessToken)).To(Equal("token"))
+					return openapi_client.ApiWorkspacesPipelineStatusRequest{ApiService: wsApiMock}
+				})
+			wsApiMock.EXPECT().WorkspacesPipelineStatusExecute(mock.Anything).Return([]api.PipelineStatus{}, nil, nil)
+
+			_, err := client.GetPipelineStateWithContext(ctx, 1, "run")
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 	Context("GitPull", func() {
 		It("sends request to pull without remote and origin", func() {
@@ -17,10 +17,12 @@ func AddAddCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
 		cmd: &cobra.Command{
 			Use:   "add",
 			Short: "Add Codesphere resources",
-			Long:  `Add resources to existing Codesphere resources, e.g. team members.`,
+			Long:  `Add resources to existing Codesphere resources, e.g. team members, organization members or SSH keys.`,
 		},
 	}
 	shared.AddCmd(rootCmd, add.cmd)
 
 	AddAddTeamMemberCmd(add.cmd, opts)
+	AddAddSshKeyCmd(add.cmd, opts)
+	AddAddOrgMemberCmd(add.cmd, opts)
 }
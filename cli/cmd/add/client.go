@@ -3,6 +3,11 @@
 
 package add
 
+import "github.com/codesphere-cloud/cs-go/api"
+
 type Client interface {
 	AddTeamMember(teamId int, email string, role int) error
+	ListSshKeys() ([]api.SshKey, error)
+	AddSshKey(publicKey string) error
+	AddOrgMember(orgId string, email string, role string) error
 }
@@ -0,0 +1,88 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package add
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type AddOrgMemberCmd struct {
+	cmd           *cobra.Command
+	Opts          AddOrgMemberOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type AddOrgMemberOpts struct {
+	shared.RootOptions
+	Email string
+	Role  string
+}
+
+func AddAddOrgMemberCmd(add *cobra.Command, opts shared.RootOptions) {
+	o := AddOrgMemberCmd{
+		cmd: &cobra.Command{
+			Use:   "org-member",
+			Short: "Add organization member",
+			Long: io.Long(`Invite a user to an organization.
+
+				The organization is selected with the -O/--org flag or the CS_ORG_ID environment variable.`),
+			Example: io.FormatExampleCommands("add org-member", []io.Example{
+				{Cmd: "-O <org-id> -e user@example.com", Desc: "Add a user to an organization as a member"},
+				{Cmd: "-O <org-id> -e admin@example.com -r admin", Desc: "Add a user to an organization as an admin"},
+			}),
+		},
+		Opts: AddOrgMemberOpts{
+			RootOptions: opts,
+		},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	o.cmd.RunE = o.RunE
+	o.cmd.Flags().StringVarP(&o.Opts.Email, "email", "e", "", "Organization member email")
+	_ = o.cmd.MarkFlagRequired("email")
+	o.cmd.Flags().StringVarP(&o.Opts.Role, "role", "r", "member", "Organization member role, e.g. member or admin")
+	shared.AddCmd(add, o.cmd)
+}
+
+func (c *AddOrgMemberCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	orgId, err := shared.RequireOrgId(c.Opts.RootOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.AddOrgMember(client, orgId, c.Opts.Email, c.Opts.Role)
+}
+
+func (c *AddOrgMemberCmd) AddOrgMember(client Client, orgId string, email string, role string) error {
+	if email == "" {
+		return errors.New("email cannot be empty")
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return fmt.Errorf("invalid email address: %w", err)
+	}
+
+	if role == "" {
+		return errors.New("role cannot be empty")
+	}
+
+	err := client.AddOrgMember(orgId, email, role)
+	if err != nil {
+		return fmt.Errorf("failed to add member to organization: %w", err)
+	}
+
+	log.Printf("Invited %s to organization %s as %s\n", email, orgId, role)
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package add_test
+
+import (
+	"errors"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	addcmd "github.com/codesphere-cloud/cs-go/cli/cmd/add"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddOrgMember", func() {
+	var (
+		mockEnv    *cmd.MockEnv
+		mockClient *cmd.MockClient
+		c          *addcmd.AddOrgMemberCmd
+		orgId      string
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		mockEnv = cmd.NewMockEnv(GinkgoT())
+		orgId = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		c = &addcmd.AddOrgMemberCmd{
+			Opts: addcmd.AddOrgMemberOpts{
+				RootOptions: &cmd.GlobalOptions{
+					Env:   mockEnv,
+					OrgId: orgId,
+				},
+			},
+			ClientFactory: func(opts shared.RootOptions) (addcmd.Client, error) {
+				return mockClient, nil
+			},
+		}
+	})
+
+	It("adds the member with the given role", func() {
+		mockClient.EXPECT().AddOrgMember(orgId, "admin@example.com", "admin").Return(nil)
+
+		err := c.AddOrgMember(mockClient, orgId, "admin@example.com", "admin")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails on invalid email addresses", func() {
+		err := c.AddOrgMember(mockClient, orgId, "not-an-email", "member")
+		Expect(err).To(MatchError(ContainSubstring("invalid email address")))
+	})
+
+	It("fails on empty roles", func() {
+		err := c.AddOrgMember(mockClient, orgId, "user@example.com", "")
+		Expect(err).To(MatchError("role cannot be empty"))
+	})
+
+	It("returns API errors", func() {
+		mockClient.EXPECT().AddOrgMember(orgId, "user@example.com", "member").Return(errors.New("forbidden"))
+
+		err := c.AddOrgMember(mockClient, orgId, "user@example.com", "member")
+		Expect(err).To(MatchError("failed to add member to organization: forbidden"))
+	})
+
+	It("requires an organization ID", func() {
+		mockEnv.EXPECT().GetOrgId().Return("")
+		c.Opts.RootOptions = &cmd.GlobalOptions{Env: mockEnv}
+
+		err := c.RunE(nil, []string{})
+		Expect(err).To(MatchError("organization ID not set, use the -O/--org flag or the CS_ORG_ID environment variable"))
+	})
+})
@@ -0,0 +1,134 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package add
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type AddSshKeyCmd struct {
+	cmd           *cobra.Command
+	Opts          AddSshKeyOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type AddSshKeyOpts struct {
+	shared.RootOptions
+	Files []string
+	// Directory searched for *.pub files when no file is given, defaults to ~/.ssh
+	KeyDir string
+}
+
+func AddAddSshKeyCmd(add *cobra.Command, opts shared.RootOptions) {
+	keyDir := ""
+	if home, err := os.UserHomeDir(); err == nil {
+		keyDir = filepath.Join(home, ".ssh")
+	}
+	k := AddSshKeyCmd{
+		cmd: &cobra.Command{
+			Use:   "ssh-key",
+			Short: "Add SSH public key",
+			Long: io.Long(`Upload SSH public keys to your Codesphere user account.
+
+				When no file is given, all public keys (*.pub) in ~/.ssh are uploaded.
+				Keys which are already known to Codesphere are skipped.`),
+			Example: io.FormatExampleCommands("add ssh-key", []io.Example{
+				{Desc: "Upload all public keys found in ~/.ssh"},
+				{Cmd: "-f ~/.ssh/id_ed25519.pub", Desc: "Upload a specific public key"},
+			}),
+		},
+		Opts: AddSshKeyOpts{
+			RootOptions: opts,
+			KeyDir:      keyDir,
+		},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	k.cmd.RunE = k.RunE
+	k.cmd.Flags().StringArrayVarP(&k.Opts.Files, "file", "f", []string{}, "Public key file to upload, can be specified multiple times (default: ~/.ssh/*.pub)")
+	shared.AddCmd(add, k.cmd)
+}
+
+func (c *AddSshKeyCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.AddSshKeys(client)
+}
+
+func (c *AddSshKeyCmd) AddSshKeys(client Client) error {
+	files, err := c.keyFiles()
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.ListSshKeys()
+	if err != nil {
+		return fmt.Errorf("failed to list SSH keys: %w", err)
+	}
+	known := map[string]bool{}
+	for _, k := range existing {
+		known[keyMaterial(k.PublicKey)] = true
+	}
+
+	for _, f := range files {
+		content, err := os.ReadFile(f)
+		if err != nil {
+			return fmt.Errorf("failed to read public key: %w", err)
+		}
+		key := strings.TrimSpace(string(content))
+		if !strings.HasPrefix(key, "ssh-") && !strings.HasPrefix(key, "ecdsa-") && !strings.HasPrefix(key, "sk-") {
+			return fmt.Errorf("%s is not an SSH public key", f)
+		}
+		if known[keyMaterial(key)] {
+			log.Printf("Skipping %s, key already added\n", f)
+			continue
+		}
+
+		err = client.AddSshKey(key)
+		if err != nil {
+			return fmt.Errorf("failed to add SSH key %s: %w", f, err)
+		}
+		known[keyMaterial(key)] = true
+		log.Printf("Added SSH key %s\n", f)
+	}
+	return nil
+}
+
+func (c *AddSshKeyCmd) keyFiles() ([]string, error) {
+	if len(c.Opts.Files) > 0 {
+		return c.Opts.Files, nil
+	}
+	if c.Opts.KeyDir == "" {
+		return nil, errors.New("failed to determine home directory, specify the key file with --file")
+	}
+
+	files, err := filepath.Glob(filepath.Join(c.Opts.KeyDir, "*.pub"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find public keys: %w", err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no public keys found in %s, generate one with ssh-keygen or specify it with --file", c.Opts.KeyDir)
+	}
+	return files, nil
+}
+
+// keyMaterial strips the comment of an authorized_keys line, so keys can be compared regardless of their title.
+func keyMaterial(key string) string {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return key
+	}
+	return fields[0] + " " + fields[1]
+}
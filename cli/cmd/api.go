@@ -0,0 +1,347 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	goio "io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+var apiMethods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+type ApiCmd struct {
+	cmd   *cobra.Command
+	Opts  ApiOpts
+	Stdin goio.Reader
+	Out   goio.Writer
+}
+
+type ApiOpts struct {
+	*GlobalOptions
+	// key=value pairs, sent as query parameters for GET and HEAD requests and as JSON body otherwise
+	RawFields []string
+	// key=value pairs like RawFields, but JSON literals like numbers, booleans and null are sent typed
+	Fields []string
+	// File with the request body, - for stdin
+	Input    string
+	Jq       string
+	Paginate bool
+	PageSize int
+}
+
+func (c *ApiCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.Api(client, args[0], args[1])
+}
+
+func AddApiCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	a := ApiCmd{
+		cmd: &cobra.Command{
+			Use:   "api <method> <path>",
+			Short: "Send authenticated requests to the Codesphere API",
+			Long: io.Long(`Send an authenticated request to any endpoint of the Codesphere API and print the response.
+
+				The path is relative to the API URL, e.g. /teams for https://codesphere.com/api/teams.
+				Fields given with -f and -F are sent as query parameters for GET and HEAD requests and as JSON object otherwise.
+				Values of -F fields are sent as numbers, booleans or null if they are JSON literals, values of -f fields always as strings.
+				JSON responses are pretty printed.
+
+				With --paginate, GET requests are repeated with increasing limit and offset query parameters
+				until all items are fetched. Items of all pages are merged into a single response.
+
+				The --jq flag selects values from the response with a subset of the jq syntax:
+				.key, ."key", .[index], .[] and pipes. Selected strings are printed without quotes.`),
+			Example: io.FormatExampleCommands("api", []io.Example{
+				{Cmd: "GET /teams", Desc: "list all teams"},
+				{Cmd: "GET /teams --jq '.[].name'", Desc: "print the names of all teams"},
+				{Cmd: "POST /teams -f name=my-team -F dc=1", Desc: "create a team"},
+				{Cmd: "PATCH /workspaces/1234 --input update.json", Desc: "update a workspace with the body from a file"},
+				{Cmd: "GET /usage/teams/1/resources/landscape-service/summary -f beginDate=2026-01-01T00:00:00Z -f endDate=2026-02-01T00:00:00Z --paginate", Desc: "fetch all pages of the landscape usage summary"},
+			}),
+			Args: cobra.ExactArgs(2),
+			ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+				if len(args) > 0 {
+					return nil, cobra.ShellCompDirectiveNoFileComp
+				}
+				return apiMethods, cobra.ShellCompDirectiveNoFileComp
+			},
+		},
+		Opts:  ApiOpts{GlobalOptions: opts},
+		Stdin: os.Stdin,
+		Out:   os.Stdout,
+	}
+	a.cmd.Flags().StringArrayVarP(&a.Opts.RawFields, "raw-field", "f", []string{}, "String request field as key=value (repeatable)")
+	a.cmd.Flags().StringArrayVarP(&a.Opts.Fields, "field", "F", []string{}, "Typed request field as key=value (repeatable)")
+	a.cmd.Flags().StringVar(&a.Opts.Input, "input", "", "File with the request body, - to read from stdin")
+	a.cmd.Flags().StringVarP(&a.Opts.Jq, "jq", "q", "", "Select values from the JSON response, e.g. '.[].name'")
+	a.cmd.Flags().BoolVar(&a.Opts.Paginate, "paginate", false, "Fetch all pages using limit and offset query parameters")
+	a.cmd.Flags().IntVar(&a.Opts.PageSize, "page-size", 100, "Number of items per page with --paginate")
+	rootCmd.AddCommand(a.cmd)
+	a.cmd.RunE = a.RunE
+}
+
+// Api sends the request and prints the response.
+func (c *ApiCmd) Api(client Client, method string, path string) error {
+	method = strings.ToUpper(method)
+	if !slices.Contains(apiMethods, method) {
+		return &UsageError{Err: fmt.Errorf("invalid method %s, must be one of %s", method, strings.Join(apiMethods, ", "))}
+	}
+	if c.Opts.Paginate && method != http.MethodGet {
+		return &UsageError{Err: fmt.Errorf("--paginate is only supported for GET requests")}
+	}
+	if c.Opts.Paginate && c.Opts.PageSize <= 0 {
+		return &UsageError{Err: fmt.Errorf("--page-size must be positive")}
+	}
+
+	path, query, err := splitApiPath(path)
+	if err != nil {
+		return err
+	}
+	body, err := c.requestBody(method, query)
+	if err != nil {
+		return err
+	}
+
+	var res []byte
+	if c.Opts.Paginate {
+		res, err = c.fetchAllPages(client, path, query)
+	} else {
+		res, err = client.Request(method, path, query, body)
+	}
+	if err != nil {
+		return fmt.Errorf("%s %s failed: %w", method, path, err)
+	}
+
+	return c.printResponse(res)
+}
+
+// splitApiPath separates query parameters given as part of the path.
+func splitApiPath(path string) (string, url.Values, error) {
+	p, rawQuery, _ := strings.Cut(path, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", nil, &UsageError{Err: fmt.Errorf("invalid query in path %s: %w", path, err)}
+	}
+	return p, query, nil
+}
+
+// requestBody returns the body from --input or the fields, adding the fields to the query instead where applicable.
+func (c *ApiCmd) requestBody(method string, query url.Values) ([]byte, error) {
+	fields := map[string]any{}
+	params := map[string]string{}
+	for _, f := range c.Opts.RawFields {
+		key, value, err := parseField(f)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = value
+		params[key] = value
+	}
+	for _, f := range c.Opts.Fields {
+		key, value, err := parseField(f)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = typedFieldValue(value)
+		params[key] = value
+	}
+
+	if c.Opts.Input != "" || method == http.MethodGet || method == http.MethodHead {
+		for key, value := range params {
+			query.Set(key, value)
+		}
+		if c.Opts.Input == "" {
+			return nil, nil
+		}
+		return c.readInput()
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal fields: %w", err)
+	}
+	return body, nil
+}
+
+func parseField(f string) (string, string, error) {
+	key, value, ok := strings.Cut(f, "=")
+	if !ok || key == "" {
+		return "", "", &UsageError{Err: fmt.Errorf("invalid field %q, must be key=value", f)}
+	}
+	return key, value, nil
+}
+
+// typedFieldValue converts JSON literals to their JSON type and keeps other values as strings.
+// Values parsed as infinite or NaN numbers, e.g. Inf, are kept as strings as JSON can't represent them.
+func typedFieldValue(value string) any {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil && !math.IsInf(n, 0) && !math.IsNaN(n) {
+		return n
+	}
+	return value
+}
+
+func (c *ApiCmd) readInput() ([]byte, error) {
+	if c.Opts.Input == "-" {
+		body, err := goio.ReadAll(c.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read body from stdin: %w", err)
+		}
+		return body, nil
+	}
+	body, err := os.ReadFile(c.Opts.Input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body from %s: %w", c.Opts.Input, err)
+	}
+	return body, nil
+}
+
+// fetchAllPages requests pages until a page is incomplete or the totalItems of the response are reached.
+//
+// Items of array responses are concatenated. For object responses, e.g. {"totalItems": 2, "summary": [...]},
+// all array fields are concatenated and the limit and offset fields are dropped.
+func (c *ApiCmd) fetchAllPages(client Client, path string, query url.Values) ([]byte, error) {
+	var merged any
+	for offset := 0; ; offset += c.Opts.PageSize {
+		query.Set("limit", strconv.Itoa(c.Opts.PageSize))
+		query.Set("offset", strconv.Itoa(offset))
+		res, err := client.Request(http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page any
+		if err := json.Unmarshal(res, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse page at offset %d: %w", offset, err)
+		}
+
+		var items int
+		merged, items, err = mergePage(merged, page, offset/c.Opts.PageSize+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if items < c.Opts.PageSize {
+			break
+		}
+		if obj, ok := page.(map[string]any); ok {
+			if total, ok := obj["totalItems"].(float64); ok && float64(offset+items) >= total {
+				break
+			}
+		}
+	}
+	return json.Marshal(merged)
+}
+
+// mergePage adds the items of page n to merged and returns the number of items in the page.
+func mergePage(merged any, page any, n int) (any, int, error) {
+	switch p := page.(type) {
+	case []any:
+		if merged == nil {
+			return p, len(p), nil
+		}
+		m, ok := merged.([]any)
+		if !ok {
+			return nil, 0, fmt.Errorf("page %d has a different shape than the first page", n)
+		}
+		return append(m, p...), len(p), nil
+	case map[string]any:
+		items := 0
+		for _, value := range p {
+			if arr, ok := value.([]any); ok {
+				items = max(items, len(arr))
+			}
+		}
+		if merged == nil {
+			delete(p, "limit")
+			delete(p, "offset")
+			return p, items, nil
+		}
+		m, ok := merged.(map[string]any)
+		if !ok {
+			return nil, 0, fmt.Errorf("page %d has a different shape than the first page", n)
+		}
+		for key, value := range p {
+			if arr, ok := value.([]any); ok {
+				existing, _ := m[key].([]any)
+				m[key] = append(existing, arr...)
+			}
+		}
+		return m, items, nil
+	default:
+		return nil, 0, fmt.Errorf("response is not paginated, expected a JSON array or object")
+	}
+}
+
+// printResponse pretty prints JSON responses, or the values selected by --jq.
+func (c *ApiCmd) printResponse(res []byte) error {
+	if c.Opts.Jq == "" {
+		if len(bytes.TrimSpace(res)) == 0 {
+			return nil
+		}
+		var pretty bytes.Buffer
+		if err := json.Indent(&pretty, res, "", "  "); err != nil {
+			// not JSON, print as is
+			_, err = c.Out.Write(res)
+			return err
+		}
+		pretty.WriteByte('\n')
+		_, err := c.Out.Write(pretty.Bytes())
+		return err
+	}
+
+	var data any
+	if err := json.Unmarshal(res, &data); err != nil {
+		return fmt.Errorf("failed to parse response as JSON: %w", err)
+	}
+	values, err := io.QueryJSON(data, c.Opts.Jq)
+	if err != nil {
+		return err
+	}
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			_, _ = fmt.Fprintln(c.Out, s)
+			continue
+		}
+		out, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal %v: %w", v, err)
+		}
+		_, _ = fmt.Fprintln(c.Out, string(out))
+	}
+	return nil
+}
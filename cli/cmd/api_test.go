@@ -0,0 +1,160 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("Api", func() {
+	var (
+		mockClient *cmd.MockClient
+		out        *bytes.Buffer
+		c          *cmd.ApiCmd
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		out = &bytes.Buffer{}
+		c = &cmd.ApiCmd{
+			Opts: cmd.ApiOpts{GlobalOptions: &cmd.GlobalOptions{}, PageSize: 2},
+			Out:  out,
+		}
+	})
+
+	It("pretty prints JSON responses", func() {
+		mockClient.EXPECT().Request("GET", "/teams", url.Values{}, []byte(nil)).Return([]byte(`[{"id":1,"name":"a"}]`), nil)
+
+		Expect(c.Api(mockClient, "get", "/teams")).To(Succeed())
+		Expect(out.String()).To(Equal("[\n  {\n    \"id\": 1,\n    \"name\": \"a\"\n  }\n]\n"))
+	})
+
+	It("prints other responses as is", func() {
+		mockClient.EXPECT().Request("GET", "/status", url.Values{}, []byte(nil)).Return([]byte("ok"), nil)
+
+		Expect(c.Api(mockClient, "GET", "/status")).To(Succeed())
+		Expect(out.String()).To(Equal("ok"))
+	})
+
+	It("sends fields as query parameters for GET requests", func() {
+		c.Opts.RawFields = []string{"beginDate=2026-01-01"}
+		c.Opts.Fields = []string{"limit=5"}
+		mockClient.EXPECT().Request("GET", "/usage", url.Values{"beginDate": {"2026-01-01"}, "limit": {"5"}, "x": {"y"}}, []byte(nil)).Return([]byte(`{}`), nil)
+
+		Expect(c.Api(mockClient, "GET", "/usage?x=y")).To(Succeed())
+	})
+
+	It("sends fields as JSON body for other requests", func() {
+		c.Opts.RawFields = []string{"name=my-team", "id=1"}
+		c.Opts.Fields = []string{"dc=1", "enabled=true", "label=x"}
+		mockClient.EXPECT().Request("POST", "/teams", url.Values{}, mock.Anything).RunAndReturn(
+			func(_ string, _ string, _ url.Values, body []byte) ([]byte, error) {
+				Expect(body).To(MatchJSON(`{"name":"my-team","id":"1","dc":1,"enabled":true,"label":"x"}`))
+				return []byte(`{"id":2}`), nil
+			})
+
+		Expect(c.Api(mockClient, "POST", "/teams")).To(Succeed())
+	})
+
+	It("sends fields which aren't finite numbers as strings", func() {
+		c.Opts.Fields = []string{"a=NaN", "b=Inf", "c=-infinity", "d=1.5"}
+		mockClient.EXPECT().Request("POST", "/teams", url.Values{}, mock.Anything).RunAndReturn(
+			func(_ string, _ string, _ url.Values, body []byte) ([]byte, error) {
+				Expect(body).To(MatchJSON(`{"a":"NaN","b":"Inf","c":"-infinity","d":1.5}`))
+				return nil, nil
+			})
+
+		Expect(c.Api(mockClient, "POST", "/teams")).To(Succeed())
+	})
+
+	It("sends the input as body", func() {
+		c.Opts.Input = "-"
+		c.Stdin = strings.NewReader(`{"replicas":2}`)
+		mockClient.EXPECT().Request("PATCH", "/workspaces/1", url.Values{}, []byte(`{"replicas":2}`)).Return(nil, nil)
+
+		Expect(c.Api(mockClient, "PATCH", "/workspaces/1")).To(Succeed())
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("selects values with --jq", func() {
+		c.Opts.Jq = ".[].name"
+		mockClient.EXPECT().Request("GET", "/teams", url.Values{}, []byte(nil)).Return([]byte(`[{"name":"a"},{"name":"b"}]`), nil)
+
+		Expect(c.Api(mockClient, "GET", "/teams")).To(Succeed())
+		Expect(out.String()).To(Equal("a\nb\n"))
+	})
+
+	It("rejects invalid fields and methods", func() {
+		c.Opts.RawFields = []string{"name"}
+		err := c.Api(mockClient, "POST", "/teams")
+		Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitUsage))
+
+		err = c.Api(mockClient, "FETCH", "/teams")
+		Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitUsage))
+	})
+
+	It("returns API errors", func() {
+		mockClient.EXPECT().Request("GET", "/teams/1", url.Values{}, []byte(nil)).Return(nil, &cserrors.APIError{StatusCode: 404, Err: errors.New("404 Not Found")})
+
+		err := c.Api(mockClient, "GET", "/teams/1")
+		Expect(err).To(MatchError(ContainSubstring("GET /teams/1 failed")))
+		Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitNotFound))
+	})
+
+	Context("with --paginate", func() {
+		BeforeEach(func() {
+			c.Opts.Paginate = true
+		})
+
+		It("concatenates array pages until a page is incomplete", func() {
+			mockClient.EXPECT().Request("GET", "/items", url.Values{"limit": {"2"}, "offset": {"0"}}, []byte(nil)).Return([]byte(`[1,2]`), nil).Once()
+			mockClient.EXPECT().Request("GET", "/items", url.Values{"limit": {"2"}, "offset": {"2"}}, []byte(nil)).Return([]byte(`[3]`), nil).Once()
+
+			Expect(c.Api(mockClient, "GET", "/items")).To(Succeed())
+			Expect(out.String()).To(MatchJSON(`[1,2,3]`))
+		})
+
+		It("merges array fields of object pages until totalItems are fetched", func() {
+			mockClient.EXPECT().Request("GET", "/summary", url.Values{"limit": {"2"}, "offset": {"0"}}, []byte(nil)).
+				Return([]byte(`{"totalItems":4,"limit":2,"offset":0,"summary":[{"id":1},{"id":2}]}`), nil).Once()
+			mockClient.EXPECT().Request("GET", "/summary", url.Values{"limit": {"2"}, "offset": {"2"}}, []byte(nil)).
+				Return([]byte(`{"totalItems":4,"limit":2,"offset":2,"summary":[{"id":3},{"id":4}]}`), nil).Once()
+
+			Expect(c.Api(mockClient, "GET", "/summary")).To(Succeed())
+			Expect(out.String()).To(MatchJSON(`{"totalItems":4,"summary":[{"id":1},{"id":2},{"id":3},{"id":4}]}`))
+		})
+
+		It("fails if pages have different shapes", func() {
+			mockClient.EXPECT().Request("GET", "/items", url.Values{"limit": {"2"}, "offset": {"0"}}, []byte(nil)).Return([]byte(`[1,2]`), nil).Once()
+			mockClient.EXPECT().Request("GET", "/items", url.Values{"limit": {"2"}, "offset": {"2"}}, []byte(nil)).Return([]byte(`{"items":[3]}`), nil).Once()
+
+			err := c.Api(mockClient, "GET", "/items")
+			Expect(err).To(MatchError(ContainSubstring("page 2 has a different shape than the first page")))
+		})
+
+		It("fails if an object page follows an array page", func() {
+			mockClient.EXPECT().Request("GET", "/summary", url.Values{"limit": {"2"}, "offset": {"0"}}, []byte(nil)).
+				Return([]byte(`{"summary":[{"id":1},{"id":2}]}`), nil).Once()
+			mockClient.EXPECT().Request("GET", "/summary", url.Values{"limit": {"2"}, "offset": {"2"}}, []byte(nil)).Return([]byte(`[3]`), nil).Once()
+
+			err := c.Api(mockClient, "GET", "/summary")
+			Expect(err).To(MatchError(ContainSubstring("page 2 has a different shape than the first page")))
+		})
+
+		It("is only supported for GET requests", func() {
+			err := c.Api(mockClient, "POST", "/items")
+			Expect(cmd.ExitCode(err)).To(Equal(cmd.ExitUsage))
+		})
+	})
+})
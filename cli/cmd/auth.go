@@ -0,0 +1,36 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type AuthCmd struct {
+	cmd *cobra.Command
+}
+
+func AddAuthCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	auth := AuthCmd{
+		cmd: &cobra.Command{
+			Use:   "auth",
+			Short: "Manage API credentials",
+			Long: io.Long(`Manage API tokens used to authenticate against the Codesphere API.
+
+				Tokens are stored per API URL in the OS keyring. If the keyring is unavailable, e.g. on headless Linux,
+				they are stored in an encrypted file next to the config file instead.
+				Set CS_CREDENTIAL_STORE to "keyring" or "file" to force a store and CS_CREDENTIALS_PASSPHRASE to encrypt the file with a passphrase.
+
+				The token is read from the CS_TOKEN environment variable, the active context or the credential store, in that order.`),
+		},
+	}
+	shared.AddCmd(rootCmd, auth.cmd)
+
+	AddAuthLoginCmd(auth.cmd, opts)
+	AddAuthStatusCmd(auth.cmd, opts)
+	AddAuthLogoutCmd(auth.cmd, opts)
+}
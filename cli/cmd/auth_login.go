@@ -0,0 +1,116 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	goio "io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type Prompt interface {
+	InputPrompt(prompt string) string
+}
+
+type AuthLoginCmd struct {
+	cmd    *cobra.Command
+	Opts   AuthLoginOpts
+	Prompt Prompt
+	Stdin  goio.Reader
+	Store  CredentialStore
+}
+
+type AuthLoginOpts struct {
+	*GlobalOptions
+	WithToken bool
+}
+
+func (c *AuthLoginCmd) RunE(_ *cobra.Command, args []string) error {
+	token, err := c.readToken()
+	if err != nil {
+		return err
+	}
+
+	client, err := c.Opts.NewClientWithToken(token)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	if c.Store == nil {
+		c.Store, err = cs.NewCredentialStore()
+		if err != nil {
+			return fmt.Errorf("failed to open credential store: %w", err)
+		}
+	}
+
+	return c.Login(client, c.Opts.GetApiUrl(), token)
+}
+
+func AddAuthLoginCmd(auth *cobra.Command, opts *GlobalOptions) {
+	login := AuthLoginCmd{
+		cmd: &cobra.Command{
+			Use:   "login",
+			Short: "Store an API token",
+			Long: io.Long(`Validate an API token and store it for the API URL.
+
+				The token is prompted for, or read from stdin with --with-token.
+				API tokens can be created in the Codesphere UI in the user settings.`),
+			Example: io.FormatExampleCommands("auth login", []io.Example{
+				{Desc: "Prompt for an API token"},
+				{Cmd: "--with-token < token.txt", Desc: "Read the API token from a file"},
+				{Cmd: "-a https://codesphere.example.com/api", Desc: "Log in to a different Codesphere installation"},
+			}),
+		},
+		Opts:   AuthLoginOpts{GlobalOptions: opts},
+		Prompt: &io.Prompt{},
+		Stdin:  os.Stdin,
+	}
+	login.cmd.Flags().BoolVar(&login.Opts.WithToken, "with-token", false, "Read the API token from stdin")
+	login.cmd.RunE = login.RunE
+	shared.AddCmd(auth, login.cmd)
+}
+
+func (c *AuthLoginCmd) readToken() (string, error) {
+	var token string
+	if c.Opts.WithToken {
+		data, err := goio.ReadAll(c.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		token = string(data)
+	} else {
+		token = c.Prompt.InputPrompt("API token")
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", errors.New("no API token given")
+	}
+	return token, nil
+}
+
+// Login validates the token by listing the teams it has access to and stores it for apiUrl.
+func (c *AuthLoginCmd) Login(client Client, apiUrl string, token string) error {
+	teams, err := client.ListTeams("")
+	if err != nil {
+		return fmt.Errorf("failed to validate API token: %w", err)
+	}
+
+	err = c.Store.Set(apiUrl, token)
+	if err != nil {
+		return fmt.Errorf("failed to store API token: %w", err)
+	}
+
+	log.Printf("Logged in to %s, the token has access to %d team(s)\n", apiUrl, len(teams))
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("AuthLogin", func() {
+	var (
+		mockClient *cmd.MockClient
+		mockStore  *cmd.MockCredentialStore
+		c          *cmd.AuthLoginCmd
+		apiUrl     string
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		mockStore = cmd.NewMockCredentialStore(GinkgoT())
+		apiUrl = "https://cloud.codesphere.com/api"
+		c = &cmd.AuthLoginCmd{
+			Opts:  cmd.AuthLoginOpts{GlobalOptions: &cmd.GlobalOptions{}},
+			Store: mockStore,
+		}
+	})
+
+	It("stores valid tokens", func() {
+		mockClient.EXPECT().ListTeams("").Return([]api.Team{{Id: 1, Name: "my-team"}}, nil)
+		mockStore.EXPECT().Set(apiUrl, "token").Return(nil)
+
+		err := c.Login(mockClient, apiUrl, "token")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("doesn't store tokens failing validation", func() {
+		mockClient.EXPECT().ListTeams("").Return(nil, errors.New("401 Unauthorized"))
+
+		err := c.Login(mockClient, apiUrl, "token")
+		Expect(err).To(MatchError("failed to validate API token: 401 Unauthorized"))
+	})
+
+	It("returns store errors", func() {
+		mockClient.EXPECT().ListTeams("").Return([]api.Team{}, nil)
+		mockStore.EXPECT().Set(apiUrl, "token").Return(errors.New("locked"))
+
+		err := c.Login(mockClient, apiUrl, "token")
+		Expect(err).To(MatchError("failed to store API token: locked"))
+	})
+})
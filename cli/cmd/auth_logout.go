@@ -0,0 +1,69 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type AuthLogoutCmd struct {
+	cmd   *cobra.Command
+	Opts  *GlobalOptions
+	Store CredentialStore
+}
+
+func (c *AuthLogoutCmd) RunE(_ *cobra.Command, args []string) error {
+	if c.Store == nil {
+		var err error
+		c.Store, err = cs.NewCredentialStore()
+		if err != nil {
+			return fmt.Errorf("failed to open credential store: %w", err)
+		}
+	}
+
+	return c.Logout(c.Opts.GetApiUrl())
+}
+
+func AddAuthLogoutCmd(auth *cobra.Command, opts *GlobalOptions) {
+	logout := AuthLogoutCmd{
+		cmd: &cobra.Command{
+			Use:   "logout",
+			Short: "Remove the stored API token",
+			Long: io.Long(`Remove the API token stored for the API URL from the credential store.
+
+				Tokens set via CS_TOKEN or a context are not affected.`),
+			Example: io.FormatExampleCommands("auth logout", []io.Example{
+				{Desc: "Remove the API token for the configured API URL"},
+			}),
+		},
+		Opts: opts,
+	}
+	logout.cmd.RunE = logout.RunE
+	shared.AddCmd(auth, logout.cmd)
+}
+
+func (c *AuthLogoutCmd) Logout(apiUrl string) error {
+	err := c.Store.Delete(apiUrl)
+	if errors.Is(err, cs.ErrNoCredentials) {
+		return fmt.Errorf("not logged in to %s", apiUrl)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to remove API token: %w", err)
+	}
+
+	log.Printf("Logged out of %s\n", apiUrl)
+	if os.Getenv("CS_TOKEN") != "" {
+		log.Println("Note: CS_TOKEN is still set and takes precedence over stored tokens")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+var _ = Describe("AuthLogout", func() {
+	var (
+		mockStore *cmd.MockCredentialStore
+		c         *cmd.AuthLogoutCmd
+		apiUrl    string
+	)
+
+	BeforeEach(func() {
+		mockStore = cmd.NewMockCredentialStore(GinkgoT())
+		apiUrl = "https://cloud.codesphere.com/api"
+		c = &cmd.AuthLogoutCmd{Opts: &cmd.GlobalOptions{}, Store: mockStore}
+	})
+
+	It("removes the stored token", func() {
+		mockStore.EXPECT().Delete(apiUrl).Return(nil)
+
+		err := c.Logout(apiUrl)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails if no token is stored", func() {
+		mockStore.EXPECT().Delete(apiUrl).Return(cs.ErrNoCredentials)
+
+		err := c.Logout(apiUrl)
+		Expect(err).To(MatchError("not logged in to https://cloud.codesphere.com/api"))
+	})
+})
@@ -0,0 +1,77 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type AuthStatusCmd struct {
+	cmd  *cobra.Command
+	Opts *GlobalOptions
+}
+
+func (c *AuthStatusCmd) RunE(_ *cobra.Command, args []string) error {
+	apiUrl := c.Opts.GetApiUrl()
+	token, source, err := c.Opts.Env.LookupApiToken(apiUrl)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.Opts.NewClientWithToken(token)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.Status(client, apiUrl, source)
+}
+
+func AddAuthStatusCmd(auth *cobra.Command, opts *GlobalOptions) {
+	status := AuthStatusCmd{
+		cmd: &cobra.Command{
+			Use:   "status",
+			Short: "Show the API token in use",
+			Long:  `Show where the API token for the API URL is read from and which teams it has access to.`,
+			Example: io.FormatExampleCommands("auth status", []io.Example{
+				{Desc: "Show the API token status for the configured API URL"},
+			}),
+		},
+		Opts: opts,
+	}
+	status.cmd.RunE = status.RunE
+	shared.AddCmd(auth, status.cmd)
+}
+
+func (c *AuthStatusCmd) Status(client Client, apiUrl string, source string) error {
+	teams, err := client.ListTeams("")
+	if err != nil {
+		return fmt.Errorf("failed to validate API token from %s: %w", source, err)
+	}
+
+	fmt.Printf("API URL: %s\n", apiUrl)
+	fmt.Printf("Token:   from %s\n", source)
+	fmt.Printf("Teams:   %d\n", len(teams))
+	if len(teams) == 0 {
+		return nil
+	}
+
+	t := io.GetTableWriter()
+	t.AppendHeader(table.Row{"ID", "Name", "Role"})
+	for _, team := range teams {
+		roleName := "N/A"
+		if team.Role != nil {
+			roleName = cs.GetRoleName(*team.Role)
+		}
+		t.AppendRow(table.Row{team.Id, team.Name, roleName})
+	}
+	t.Render()
+	return nil
+}
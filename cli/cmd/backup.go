@@ -0,0 +1,25 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type BackupCmd struct {
+	cmd *cobra.Command
+}
+
+func AddBackupCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	backup := BackupCmd{
+		cmd: &cobra.Command{
+			Use:   "backup",
+			Short: "Backup Codesphere resources",
+			Long:  `Trigger backups of Codesphere resources, like managed services.`,
+		},
+	}
+	rootCmd.AddCommand(backup.cmd)
+
+	AddBackupServiceCmd(backup.cmd, opts)
+}
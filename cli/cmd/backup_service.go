@@ -0,0 +1,59 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type BackupServiceCmd struct {
+	cmd  *cobra.Command
+	Opts BackupServiceOpts
+}
+
+type BackupServiceOpts struct {
+	*GlobalOptions
+	ServiceId string
+}
+
+func (c *BackupServiceCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.BackupService(client)
+}
+
+func AddBackupServiceCmd(backup *cobra.Command, opts *GlobalOptions) {
+	service := BackupServiceCmd{
+		cmd: &cobra.Command{
+			Use:   "service",
+			Short: "Schedule a backup of a managed service",
+			Long:  io.Long(`Schedule a backup of a managed service. The backup is taken asynchronously by the service provider.`),
+			Example: io.FormatExampleCommands("backup service", []io.Example{
+				{Cmd: "--id <service-id>", Desc: "Schedule a backup of a managed service"},
+			}),
+		},
+		Opts: BackupServiceOpts{GlobalOptions: opts},
+	}
+	service.cmd.Flags().StringVar(&service.Opts.ServiceId, "id", "", "ID of the managed service")
+	_ = service.cmd.MarkFlagRequired("id")
+	service.cmd.RunE = service.RunE
+	backup.AddCommand(service.cmd)
+}
+
+func (c *BackupServiceCmd) BackupService(client Client) error {
+	backup, err := client.ScheduleManagedServiceBackup(c.Opts.ServiceId)
+	if err != nil {
+		return fmt.Errorf("failed to schedule backup: %w", err)
+	}
+
+	log.Printf("Backup %s of managed service %s scheduled at %s\n", backup.Id, c.Opts.ServiceId, backup.ScheduledAt.Format("2006-01-02 15:04:05"))
+	return nil
+}
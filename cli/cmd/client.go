@@ -25,10 +25,12 @@ type Client interface {
 	WorkspaceStatus(workspaceId int) (*api.WorkspaceStatus, error)
 	WaitForWorkspaceRunning(workspace *api.Workspace, timeout time.Duration) error
 	ScaleWorkspace(wsId int, replicas int) error
+	UpdateWorkspace(wsId int, args api.UpdateWorkspaceArgs) error
 	ScaleLandscapeServices(wsId int, services map[string]int) error
 	SetEnvVarOnWorkspace(workspaceId int, vars map[string]string) error
 	ExecCommand(workspaceId int, command string, workdir string, env map[string]string) (string, string, error)
 	ListWorkspacePlans() ([]api.WorkspacePlan, error)
+	PlanByName(name string) (api.WorkspacePlan, error)
 	DeployWorkspace(args api.DeployWorkspaceArgs) (*api.Workspace, error)
 	DeleteWorkspace(wsId int) error
 	StartPipelineStage(wsId int, profile string, stage string) error
@@ -41,6 +43,48 @@ type Client interface {
 	AddTeamMember(teamId int, email string, role int) error
 	RemoveTeamMember(teamId int, userId int) error
 	ListTeamMembers(teamId int) ([]api.TeamMember, error)
+	ListManagedServices(teamId int) ([]api.ManagedService, error)
+	ListManagedServiceProviders(teamId int) ([]api.ManagedServiceProvider, error)
+	CreateManagedService(args api.CreateManagedServiceArgs) (*api.ManagedService, error)
+	UpdateManagedService(serviceId string, args api.UpdateManagedServiceArgs) (*api.ManagedService, error)
+	DeleteManagedService(serviceId string) error
+	ScheduleManagedServiceBackup(serviceId string) (*api.ManagedServiceBackup, error)
+	ListWorkspaceSecrets(teamId int, workspaceId int) ([]string, error)
+	StoreWorkspaceSecrets(teamId int, workspaceId int, secrets map[string]string) error
+	DeleteWorkspaceSecrets(teamId int, workspaceId int, keys []string) error
+	GenerateWorkspaceSecrets(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error)
+	ListSharedVaults(teamId int) ([]string, error)
+	CreateSharedVault(teamId int, name string) error
+	DeleteSharedVault(teamId int, name string) error
+	ListSharedSecrets(teamId int, vaultName string) ([]string, error)
+	StoreSharedSecrets(teamId int, vaultName string, secrets map[string]string) error
+	DeleteSharedSecrets(teamId int, vaultName string, keys []string) error
+	ListSshKeys() ([]api.SshKey, error)
+	AddSshKey(publicKey string) error
+	DeleteSshKey(fingerprint string) error
+	ListDomains(teamId int) ([]api.Domain, error)
+	GetDomain(teamId int, domainName string) (*api.Domain, error)
+	CreateDomain(teamId int, domainName string) (*api.Domain, error)
+	DeleteDomain(teamId int, domainName string) error
+	VerifyDomain(teamId int, domainName string) (*api.DomainVerificationStatus, error)
+	UpdateWorkspaceConnections(teamId int, domainName string, connections api.PathToWorkspaces) (*api.Domain, error)
+	ListEnvVars(workspaceId int) ([]api.EnvVar, error)
+	DeleteEnvVars(workspaceId int, names []string) error
+	TeardownLandscape(wsId int) error
+	ListOrgMembers(orgId string) ([]api.OrgMember, error)
+	AddOrgMember(orgId string, email string, role string) error
+	ChangeOrgMemberRole(orgId string, userId int, role string) error
+	RemoveOrgMember(orgId string, userId int) error
+	MigrateTeamToOrg(teamId int, orgId string, force bool) error
+	ChangeTeamMemberRole(teamId int, userId int, role int) error
+	Request(method string, path string, query url.Values, body []byte) ([]byte, error)
+}
+
+// CredentialStore persists API tokens per API URL, see [cs.NewCredentialStore]
+type CredentialStore interface {
+	Get(apiUrl string) (string, error)
+	Set(apiUrl string, token string) error
+	Delete(apiUrl string) error
 }
 
 // CommandExecutor abstracts command execution for testing
@@ -55,10 +99,15 @@ func NewClient(opts GlobalOptions) (Client, error) {
 }
 
 func (o GlobalOptions) NewClient() (*api.Client, error) {
-	token, err := o.Env.GetApiToken()
+	token, err := o.GetApiToken()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get API token: %w", err)
 	}
+	return o.NewClientWithToken(token)
+}
+
+// NewClientWithToken creates a client for the configured API URL, authenticated with the given token.
+func (o GlobalOptions) NewClientWithToken(token string) (*api.Client, error) {
 	apiUrl, err := url.Parse(o.GetApiUrl())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse URL '%s': %w", o.GetApiUrl(), err)
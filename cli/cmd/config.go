@@ -0,0 +1,77 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type ConfigCmd struct {
+	cmd *cobra.Command
+}
+
+// ConfigOpts are shared by all config subcommands.
+type ConfigOpts struct {
+	// Path of the config file, resolved via cs.ConfigPath() if empty
+	Path string
+}
+
+func (o ConfigOpts) load() (*cs.Config, string, error) {
+	path := o.Path
+	if path == "" {
+		var err error
+		path, err = cs.ConfigPath()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	config, err := cs.LoadConfig(path)
+	if err != nil {
+		return nil, "", err
+	}
+	return config, path, nil
+}
+
+func AddConfigCmd(rootCmd *cobra.Command) {
+	config := ConfigCmd{
+		cmd: &cobra.Command{
+			Use:   "config",
+			Short: "Manage CLI configuration",
+			Long: io.Long(`Manage named contexts in the CLI config file, e.g. one per Codesphere installation.
+
+				Each context can hold the API URL, API token, team, workspace and organization to use.
+				Values are resolved in the order: command line flag, environment variable, active context.
+
+				The config file is located at ~/.config/cs/config.yaml, which can be overridden with the CS_CONFIG environment variable.
+				The active context can be overridden with the CS_CONTEXT environment variable.
+
+				Valid keys are: ` + strings.Join(cs.ConfigKeys, ", ")),
+		},
+	}
+	shared.AddCmd(rootCmd, config.cmd)
+
+	AddConfigUseContextCmd(config.cmd)
+	AddConfigSetCmd(config.cmd)
+	AddConfigGetCmd(config.cmd)
+	AddConfigListCmd(config.cmd)
+}
+
+// selectContext returns the name of the given context or the active context if empty.
+func selectContext(config *cs.Config, name string) (string, error) {
+	if name != "" {
+		return name, nil
+	}
+	name = config.ActiveContextName()
+	if name == "" {
+		return "", fmt.Errorf("no context selected, use --context or '%s config use-context'", io.BinName())
+	}
+	return name, nil
+}
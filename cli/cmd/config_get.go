@@ -0,0 +1,67 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type ConfigGetCmd struct {
+	cmd  *cobra.Command
+	Opts ConfigGetOpts
+}
+
+type ConfigGetOpts struct {
+	ConfigOpts
+	Context string
+}
+
+func (c *ConfigGetCmd) RunE(_ *cobra.Command, args []string) error {
+	value, err := c.Get(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Println(value)
+	return nil
+}
+
+func AddConfigGetCmd(config *cobra.Command) {
+	get := ConfigGetCmd{
+		cmd: &cobra.Command{
+			Use:   "get KEY",
+			Short: "Get a value of a context",
+			Long:  io.Long(`Print a value of the active or the given context, empty if unset.`),
+			Args:  cobra.ExactArgs(1),
+			Example: io.FormatExampleCommands("config get", []io.Example{
+				{Cmd: "team", Desc: "Print the default team of the active context"},
+				{Cmd: "api --context on-prem", Desc: "Print the API URL of the context 'on-prem'"},
+			}),
+		},
+	}
+	get.cmd.Flags().StringVar(&get.Opts.Context, "context", "", "Context to read (default: active context)")
+	get.cmd.RunE = get.RunE
+	config.AddCommand(get.cmd)
+}
+
+func (c *ConfigGetCmd) Get(key string) (string, error) {
+	config, _, err := c.Opts.load()
+	if err != nil {
+		return "", err
+	}
+
+	name, err := selectContext(config, c.Opts.Context)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, ok := config.Contexts[name]
+	if !ok {
+		return "", fmt.Errorf("context %s not found", name)
+	}
+	return ctx.Get(key)
+}
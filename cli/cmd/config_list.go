@@ -0,0 +1,63 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type ConfigListCmd struct {
+	cmd  *cobra.Command
+	Opts ConfigOpts
+}
+
+func (c *ConfigListCmd) RunE(_ *cobra.Command, args []string) error {
+	return c.List()
+}
+
+func AddConfigListCmd(config *cobra.Command) {
+	list := ConfigListCmd{
+		cmd: &cobra.Command{
+			Use:   "list",
+			Short: "List contexts",
+			Long:  io.Long(`List all contexts of the config file. The active context is marked with '*', tokens are not printed.`),
+			Example: io.FormatExampleCommands("config list", []io.Example{
+				{Desc: "List all contexts"},
+			}),
+		},
+	}
+	list.cmd.RunE = list.RunE
+	config.AddCommand(list.cmd)
+}
+
+func (c *ConfigListCmd) List() error {
+	config, _, err := c.Opts.load()
+	if err != nil {
+		return err
+	}
+
+	active := config.ActiveContextName()
+	t := io.GetTableWriter()
+	t.AppendHeader(table.Row{"Current", "Name", "API", "Token", "Team", "Workspace", "Org"})
+	for _, name := range config.ContextNames() {
+		ctx := config.Contexts[name]
+		current := ""
+		if name == active {
+			current = "*"
+		}
+		token := ""
+		if ctx.Token != "" {
+			token = "(set)"
+		}
+		team, _ := ctx.Get("team")
+		workspace, _ := ctx.Get("workspace")
+		t.AppendRow(table.Row{current, name, ctx.ApiUrl, token, team, workspace, ctx.OrgId})
+	}
+	t.Render()
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type ConfigSetCmd struct {
+	cmd  *cobra.Command
+	Opts ConfigSetOpts
+}
+
+type ConfigSetOpts struct {
+	ConfigOpts
+	Context string
+}
+
+func (c *ConfigSetCmd) RunE(_ *cobra.Command, args []string) error {
+	return c.Set(args[0], args[1])
+}
+
+func AddConfigSetCmd(config *cobra.Command) {
+	set := ConfigSetCmd{
+		cmd: &cobra.Command{
+			Use:   "set KEY VALUE",
+			Short: "Set a value of a context",
+			Long: io.Long(`Set a value of the active or the given context.
+
+				The context is created if it doesn't exist. An empty value unsets the key.`),
+			Args: cobra.ExactArgs(2),
+			Example: io.FormatExampleCommands("config set", []io.Example{
+				{Cmd: "team 42", Desc: "Set the default team of the active context"},
+				{Cmd: "api https://codesphere.example.com/api --context on-prem", Desc: "Set the API URL of the context 'on-prem'"},
+				{Cmd: "workspace ''", Desc: "Unset the default workspace of the active context"},
+			}),
+		},
+	}
+	set.cmd.Flags().StringVar(&set.Opts.Context, "context", "", "Context to modify (default: active context)")
+	set.cmd.RunE = set.RunE
+	config.AddCommand(set.cmd)
+}
+
+func (c *ConfigSetCmd) Set(key string, value string) error {
+	config, path, err := c.Opts.load()
+	if err != nil {
+		return err
+	}
+
+	name, err := selectContext(config, c.Opts.Context)
+	if err != nil {
+		return err
+	}
+
+	ctx, ok := config.Contexts[name]
+	if !ok {
+		ctx = &cs.Context{}
+		config.Contexts[name] = ctx
+	}
+	if err := ctx.Set(key, value); err != nil {
+		return err
+	}
+
+	if err := config.Save(path); err != nil {
+		return err
+	}
+	log.Printf("Set %s of context %s\n", key, name)
+	return nil
+}
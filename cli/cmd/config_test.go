@@ -0,0 +1,92 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+var _ = Describe("Config", func() {
+	var opts cmd.ConfigOpts
+
+	BeforeEach(func() {
+		GinkgoT().Setenv("CS_CONTEXT", "")
+		opts = cmd.ConfigOpts{Path: filepath.Join(GinkgoT().TempDir(), "config.yaml")}
+	})
+
+	It("sets and gets values of the active context", func() {
+		use := &cmd.ConfigUseContextCmd{Opts: cmd.ConfigUseContextOpts{ConfigOpts: opts, Create: true}}
+		Expect(use.UseContext("on-prem")).To(Succeed())
+
+		set := &cmd.ConfigSetCmd{Opts: cmd.ConfigSetOpts{ConfigOpts: opts}}
+		Expect(set.Set("team", "42")).To(Succeed())
+
+		get := &cmd.ConfigGetCmd{Opts: cmd.ConfigGetOpts{ConfigOpts: opts}}
+		Expect(get.Get("team")).To(Equal("42"))
+
+		config, err := cs.LoadConfig(opts.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.CurrentContext).To(Equal("on-prem"))
+		Expect(config.Contexts["on-prem"].TeamId).To(Equal(42))
+	})
+
+	It("creates contexts given with --context on set", func() {
+		set := &cmd.ConfigSetCmd{Opts: cmd.ConfigSetOpts{ConfigOpts: opts, Context: "saas"}}
+		Expect(set.Set("api", "https://cloud.codesphere.com/api")).To(Succeed())
+
+		get := &cmd.ConfigGetCmd{Opts: cmd.ConfigGetOpts{ConfigOpts: opts, Context: "saas"}}
+		Expect(get.Get("api")).To(Equal("https://cloud.codesphere.com/api"))
+	})
+
+	It("fails to set values without a context", func() {
+		set := &cmd.ConfigSetCmd{Opts: cmd.ConfigSetOpts{ConfigOpts: opts}}
+		Expect(set.Set("team", "42")).To(MatchError(ContainSubstring("no context selected")))
+	})
+
+	It("fails to use unknown contexts without --create", func() {
+		use := &cmd.ConfigUseContextCmd{Opts: cmd.ConfigUseContextOpts{ConfigOpts: opts}}
+		Expect(use.UseContext("unknown")).To(MatchError("context unknown not found, use --create to create it"))
+	})
+})
+
+var _ = Describe("GlobalOptions", func() {
+	BeforeEach(func() {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		GinkgoT().Setenv("CS_CONFIG", path)
+		GinkgoT().Setenv("CS_CONTEXT", "")
+		GinkgoT().Setenv("CS_TOKEN", "")
+		GinkgoT().Setenv("CS_API", "")
+		GinkgoT().Setenv("CS_CREDENTIAL_STORE", "file")
+		GinkgoT().Setenv("CS_CREDENTIALS_PASSPHRASE", "secret")
+
+		config := &cs.Config{
+			CurrentContext: "onprem-a",
+			Contexts: map[string]*cs.Context{
+				"onprem-a": {ApiUrl: "https://onprem-a.example.com/api", Token: "ctx-token"},
+			},
+		}
+		Expect(config.Save(path)).To(Succeed())
+	})
+
+	It("uses the stored token of the URL given with --api instead of the token of the active context", func() {
+		store, err := cs.NewCredentialStore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Set("https://onprem-b.example.com/api", "stored-token")).To(Succeed())
+
+		opts := cmd.GlobalOptions{Env: cs.NewEnv(), ApiUrl: "https://onprem-b.example.com/api"}
+		Expect(opts.GetApiToken()).To(Equal("stored-token"))
+	})
+
+	It("doesn't send the token of the active context to the URL given with --api", func() {
+		opts := cmd.GlobalOptions{Env: cs.NewEnv(), ApiUrl: "https://onprem-b.example.com/api"}
+		_, err := opts.GetApiToken()
+		Expect(err).To(MatchError(cs.ErrNoApiToken))
+	})
+})
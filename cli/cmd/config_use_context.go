@@ -0,0 +1,67 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type ConfigUseContextCmd struct {
+	cmd  *cobra.Command
+	Opts ConfigUseContextOpts
+}
+
+type ConfigUseContextOpts struct {
+	ConfigOpts
+	Create bool
+}
+
+func (c *ConfigUseContextCmd) RunE(_ *cobra.Command, args []string) error {
+	return c.UseContext(args[0])
+}
+
+func AddConfigUseContextCmd(config *cobra.Command) {
+	useContext := ConfigUseContextCmd{
+		cmd: &cobra.Command{
+			Use:   "use-context NAME",
+			Short: "Switch the active context",
+			Long:  io.Long(`Switch the active context used for all following commands.`),
+			Args:  cobra.ExactArgs(1),
+			Example: io.FormatExampleCommands("config use-context", []io.Example{
+				{Cmd: "on-prem", Desc: "Use the context 'on-prem'"},
+				{Cmd: "staging --create", Desc: "Create the empty context 'staging' and use it"},
+			}),
+		},
+	}
+	useContext.cmd.Flags().BoolVar(&useContext.Opts.Create, "create", false, "Create the context if it doesn't exist")
+	useContext.cmd.RunE = useContext.RunE
+	config.AddCommand(useContext.cmd)
+}
+
+func (c *ConfigUseContextCmd) UseContext(name string) error {
+	config, path, err := c.Opts.load()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := config.Contexts[name]; !ok {
+		if !c.Opts.Create {
+			return fmt.Errorf("context %s not found, use --create to create it", name)
+		}
+		config.Contexts[name] = &cs.Context{}
+	}
+	config.CurrentContext = name
+
+	if err := config.Save(path); err != nil {
+		return err
+	}
+	log.Printf("Switched to context %s\n", name)
+	return nil
+}
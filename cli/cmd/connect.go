@@ -0,0 +1,25 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type ConnectCmd struct {
+	cmd *cobra.Command
+}
+
+func AddConnectCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	connect := ConnectCmd{
+		cmd: &cobra.Command{
+			Use:   "connect",
+			Short: "Connect Codesphere resources",
+			Long:  `Connect Codesphere resources, like custom domains, to workspaces.`,
+		},
+	}
+	rootCmd.AddCommand(connect.cmd)
+
+	AddConnectDomainCmd(connect.cmd, opts)
+}
@@ -0,0 +1,137 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type ConnectDomainCmd struct {
+	cmd  *cobra.Command
+	Opts ConnectDomainOpts
+}
+
+type ConnectDomainOpts struct {
+	*GlobalOptions
+	Name    string
+	Paths   []string
+	Replace bool
+}
+
+func (c *ConnectDomainCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.ConnectDomain(client, teamId)
+}
+
+func AddConnectDomainCmd(connect *cobra.Command, opts *GlobalOptions) {
+	domain := ConnectDomainCmd{
+		cmd: &cobra.Command{
+			Use:   "domain",
+			Short: "Connect workspaces to a custom domain",
+			Long: io.Long(`Connect paths of a custom domain to workspaces.
+
+				Each path can be served by one or more workspaces, given as comma separated list of workspace IDs.
+				Existing connections of other paths are kept unless --replace is set.
+				An empty list of workspace IDs removes the connection of a path.`),
+			Example: io.FormatExampleCommands("connect domain", []io.Example{
+				{Cmd: "-t <team-id> -n app.example.com --path /=<workspace-id>", Desc: "Serve the whole domain from a workspace"},
+				{Cmd: "-t <team-id> -n app.example.com --path /api=<workspace-id> --path /=<workspace-id>,<workspace-id>", Desc: "Connect multiple paths, serving / from two workspaces"},
+				{Cmd: "-t <team-id> -n app.example.com --path /api=", Desc: "Remove the connection of the /api path"},
+				{Cmd: "-t <team-id> -n app.example.com --path /=<workspace-id> --replace", Desc: "Replace all existing connections"},
+			}),
+		},
+		Opts: ConnectDomainOpts{GlobalOptions: opts},
+	}
+	domain.cmd.Flags().StringVarP(&domain.Opts.Name, "name", "n", "", "Domain name")
+	domain.cmd.Flags().StringArrayVar(&domain.Opts.Paths, "path", []string{}, "Connection in form path=workspace-id[,workspace-id...], can be specified multiple times")
+	domain.cmd.Flags().BoolVar(&domain.Opts.Replace, "replace", false, "Replace all existing connections of the domain instead of merging")
+	_ = domain.cmd.MarkFlagRequired("name")
+	_ = domain.cmd.MarkFlagRequired("path")
+	domain.cmd.RunE = domain.RunE
+	connect.AddCommand(domain.cmd)
+}
+
+func (c *ConnectDomainCmd) ConnectDomain(client Client, teamId int) error {
+	paths, err := ParsePathToWorkspaces(c.Opts.Paths)
+	if err != nil {
+		return fmt.Errorf("failed to parse paths: %w", err)
+	}
+
+	connections := api.PathToWorkspaces{}
+	if !c.Opts.Replace {
+		domain, err := client.GetDomain(teamId, c.Opts.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get domain %s: %w", c.Opts.Name, err)
+		}
+		for path, ids := range domain.Workspaces {
+			connections[path] = toWorkspaceRefs(ids)
+		}
+	}
+	for path, ids := range paths {
+		if len(ids) == 0 {
+			delete(connections, path)
+			continue
+		}
+		connections[path] = toWorkspaceRefs(ids)
+	}
+
+	domain, err := client.UpdateWorkspaceConnections(teamId, c.Opts.Name, connections)
+	if err != nil {
+		return fmt.Errorf("failed to update workspace connections: %w", err)
+	}
+
+	log.Printf("Workspace connections of domain %s updated, %d path(s) connected\n", domain.Name, len(domain.Workspaces))
+	return nil
+}
+
+// ParsePathToWorkspaces parses a string slice like ["/api=1,2", "/old="] into a map of path to workspace IDs.
+// An empty list of IDs marks a path to be disconnected.
+func ParsePathToWorkspaces(paths []string) (map[string][]int, error) {
+	res := map[string][]int{}
+	for _, p := range paths {
+		path, value, found := strings.Cut(p, "=")
+		if !found || !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("invalid format '%s', expected '/path=workspace-id[,workspace-id...]'", p)
+		}
+		ids := []int{}
+		if value != "" {
+			for _, v := range strings.Split(value, ",") {
+				id, err := strconv.Atoi(strings.TrimSpace(v))
+				if err != nil {
+					return nil, fmt.Errorf("invalid workspace ID '%s' for path '%s': %w", v, path, err)
+				}
+				ids = append(ids, id)
+			}
+		}
+		if _, exists := res[path]; exists {
+			return nil, fmt.Errorf("path '%s' specified multiple times", path)
+		}
+		res[path] = ids
+	}
+	return res, nil
+}
+
+func toWorkspaceRefs(ids []int) []*api.Workspace {
+	workspaces := make([]*api.Workspace, len(ids))
+	for i, id := range ids {
+		workspaces[i] = &api.Workspace{Id: id}
+	}
+	return workspaces
+}
@@ -0,0 +1,84 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("ConnectDomain", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.ConnectDomainCmd
+		teamId     int
+		domainName string
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		teamId = 5
+		domainName = "app.example.com"
+		c = &cmd.ConnectDomainCmd{
+			Opts: cmd.ConnectDomainOpts{
+				GlobalOptions: &cmd.GlobalOptions{},
+				Name:          domainName,
+			},
+		}
+	})
+
+	It("merges new paths with existing connections", func() {
+		c.Opts.Paths = []string{"/api=42", "/old="}
+		mockClient.EXPECT().GetDomain(teamId, domainName).Return(&api.Domain{
+			Name:       domainName,
+			Workspaces: map[string][]int{"/": {1, 2}, "/old": {3}},
+		}, nil)
+		mockClient.EXPECT().UpdateWorkspaceConnections(teamId, domainName, api.PathToWorkspaces{
+			"/":    {{Id: 1}, {Id: 2}},
+			"/api": {{Id: 42}},
+		}).Return(&api.Domain{Name: domainName}, nil)
+
+		err := c.ConnectDomain(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("replaces existing connections with --replace", func() {
+		c.Opts.Paths = []string{"/=7,8"}
+		c.Opts.Replace = true
+		mockClient.EXPECT().UpdateWorkspaceConnections(teamId, domainName, api.PathToWorkspaces{
+			"/": {{Id: 7}, {Id: 8}},
+		}).Return(&api.Domain{Name: domainName}, nil)
+
+		err := c.ConnectDomain(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails on invalid paths", func() {
+		c.Opts.Paths = []string{"api=1"}
+
+		err := c.ConnectDomain(mockClient, teamId)
+		Expect(err).To(MatchError(ContainSubstring("invalid format 'api=1'")))
+	})
+})
+
+var _ = Describe("ParsePathToWorkspaces", func() {
+	It("parses paths with multiple workspaces", func() {
+		res, err := cmd.ParsePathToWorkspaces([]string{"/=1, 2", "/api=3", "/old="})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(map[string][]int{"/": {1, 2}, "/api": {3}, "/old": {}}))
+	})
+
+	It("fails on invalid workspace IDs", func() {
+		_, err := cmd.ParsePathToWorkspaces([]string{"/=abc"})
+		Expect(err).To(MatchError(ContainSubstring("invalid workspace ID 'abc'")))
+	})
+
+	It("fails on duplicate paths", func() {
+		_, err := cmd.ParsePathToWorkspaces([]string{"/=1", "/=2"})
+		Expect(err).To(MatchError("path '/' specified multiple times"))
+	})
+})
@@ -7,8 +7,13 @@ import "github.com/codesphere-cloud/cs-go/api"
 
 type Client interface {
 	DeployWorkspace(args api.DeployWorkspaceArgs) (*api.Workspace, error)
+	GetWorkspace(workspaceId int) (api.Workspace, error)
+	ListEnvVars(workspaceId int) ([]api.EnvVar, error)
+	DeployLandscape(wsId int, profile string) error
 	ListBaseimages() ([]api.Baseimage, error)
 	SetEnvVarOnWorkspace(workspaceId int, vars map[string]string) error
 	CreateOrganization(name string, adminEmail string) (*api.Organization, error)
 	CreateTeam(orgId string, teamName string, dcId int) (*api.Team, error)
+	CreateManagedService(args api.CreateManagedServiceArgs) (*api.ManagedService, error)
+	CreateDomain(teamId int, domainName string) (*api.Domain, error)
 }
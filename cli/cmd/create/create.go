@@ -26,4 +26,6 @@ func AddCreateCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
 	AddCreateOrganizationCmd(create.cmd, opts)
 	AddCreateEnvCmd(create.cmd, opts)
 	AddCreateTeamCmd(create.cmd, opts)
+	AddCreateServiceCmd(create.cmd, opts)
+	AddCreateDomainCmd(create.cmd, opts)
 }
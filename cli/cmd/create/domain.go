@@ -0,0 +1,82 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type CreateDomainCmd struct {
+	cmd           *cobra.Command
+	Opts          CreateDomainOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type CreateDomainOpts struct {
+	shared.RootOptions
+	Name string
+}
+
+func AddCreateDomainCmd(create *cobra.Command, opts shared.RootOptions) {
+	d := CreateDomainCmd{
+		cmd: &cobra.Command{
+			Use:   "domain",
+			Short: "Create custom domain",
+			Long: io.Long(`Create a custom domain in a team.
+
+				The domain needs to be verified before it can be used, see 'verify domain'.`),
+			Example: io.FormatExampleCommands("create domain", []io.Example{
+				{Cmd: "-t <team-id> -n app.example.com", Desc: "Create a custom domain"},
+			}),
+		},
+		Opts:          CreateDomainOpts{RootOptions: opts},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	d.cmd.Flags().StringVarP(&d.Opts.Name, "name", "n", "", "Domain name")
+	_ = d.cmd.MarkFlagRequired("name")
+	d.cmd.RunE = d.RunE
+	shared.AddCmd(create, d.cmd)
+}
+
+func (c *CreateDomainCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	domain, err := c.CreateDomain(client, teamId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Domain %s created, set the following DNS records and run 'verify domain':\n", domain.Name)
+	log.Printf("  A     %s\n", domain.DnsEntries.A)
+	log.Printf("  CNAME %s\n", domain.DnsEntries.Cname)
+	log.Printf("  TXT   %s\n", domain.DnsEntries.Txt)
+	return nil
+}
+
+func (c *CreateDomainCmd) CreateDomain(client Client, teamId int) (*api.Domain, error) {
+	if c.Opts.Name == "" {
+		return nil, errors.New("domain name cannot be empty")
+	}
+
+	domain, err := client.CreateDomain(teamId, c.Opts.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain: %w", err)
+	}
+	return domain, nil
+}
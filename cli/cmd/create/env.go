@@ -4,8 +4,11 @@
 package create
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"os"
 
 	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	"github.com/codesphere-cloud/cs-go/pkg/cs"
@@ -20,7 +23,8 @@ type CreateEnvCmd struct {
 
 type CreateEnvOptions struct {
 	shared.RootOptions
-	EnvVar *[]string
+	EnvVar   *[]string
+	FromFile string
 }
 
 func AddCreateEnvCmd(p *cobra.Command, opts shared.RootOptions) {
@@ -28,10 +32,15 @@ func AddCreateEnvCmd(p *cobra.Command, opts shared.RootOptions) {
 		cmd: &cobra.Command{
 			Use:   "env",
 			Short: "Set environment variables",
-			Long:  `Set environment variables in a workspace`,
+			Long: io.Long(`Set environment variables in a workspace.
+
+				Variables can be read from a file in .env syntax, e.g. exported with 'list env -o dotenv'.
+				Variables passed with --env-var take precedence over variables from the file.`),
 			Example: io.FormatExampleCommands("create env", []io.Example{
 				{Cmd: "--workspace <workspace-id> --env-var foo=bar", Desc: "Set single environment variable"},
 				{Cmd: "--workspace <workspace-id> --env-var foo=bar --env-var hello=world", Desc: "Set multiple environment variables"},
+				{Cmd: "--workspace <workspace-id> --from-file .env", Desc: "Set all environment variables defined in a .env file"},
+				{Cmd: "--workspace <workspace-id> --from-file -", Desc: "Set all environment variables read from stdin in .env syntax"},
 			}),
 		},
 		Opts: CreateEnvOptions{RootOptions: opts},
@@ -43,6 +52,7 @@ func AddCreateEnvCmd(p *cobra.Command, opts shared.RootOptions) {
 
 func (l *CreateEnvCmd) parseFlags() {
 	l.Opts.EnvVar = l.cmd.Flags().StringArrayP("env-var", "e", []string{}, "env vars to set in form key=val")
+	l.cmd.Flags().StringVar(&l.Opts.FromFile, "from-file", "", "file in .env syntax to read env vars from, - for stdin")
 }
 
 func (l *CreateEnvCmd) RunE(_ *cobra.Command, args []string) (err error) {
@@ -55,9 +65,12 @@ func (l *CreateEnvCmd) RunE(_ *cobra.Command, args []string) (err error) {
 }
 
 func (l *CreateEnvCmd) SetEnvironmentVariables(client Client) (err error) {
-	envVarMap, err := cs.ArgToEnvVarMap(*l.Opts.EnvVar)
+	envVarMap, err := l.collectEnvVars()
 	if err != nil {
-		return fmt.Errorf("failed to parse environment variables: %w", err)
+		return err
+	}
+	if len(envVarMap) == 0 {
+		return errors.New("no environment variables given, use --env-var or --from-file")
 	}
 	wsId, err := l.Opts.GetWorkspaceId()
 	if err != nil {
@@ -66,9 +79,39 @@ func (l *CreateEnvCmd) SetEnvironmentVariables(client Client) (err error) {
 
 	err = client.SetEnvVarOnWorkspace(wsId, envVarMap)
 	if err != nil {
-		return fmt.Errorf("failed to set environment variables %v: %w", envVarMap, err)
+		return fmt.Errorf("failed to set environment variables: %w", err)
 	}
 
 	log.Printf("Environment variables set successfully on workspace %d\n", wsId)
 	return nil
 }
+
+func (l *CreateEnvCmd) collectEnvVars() (map[string]string, error) {
+	envVarMap := map[string]string{}
+	if l.Opts.FromFile != "" {
+		fileVars, err := readDotEnvFile(l.Opts.FromFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read environment variables from %s: %w", l.Opts.FromFile, err)
+		}
+		maps.Copy(envVarMap, fileVars)
+	}
+
+	argVars, err := cs.ArgToEnvVarMap(*l.Opts.EnvVar)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse environment variables: %w", err)
+	}
+	maps.Copy(envVarMap, argVars)
+	return envVarMap, nil
+}
+
+func readDotEnvFile(path string) (map[string]string, error) {
+	if path == "-" {
+		return cs.ParseDotEnv(os.Stdin)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	return cs.ParseDotEnv(f)
+}
@@ -4,6 +4,9 @@
 package create_test
 
 import (
+	"os"
+	"path/filepath"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -63,6 +66,35 @@ var _ = Describe("SetEnvVars", func() {
 
 	})
 
+	Context("Env vars from file", func() {
+		var envFile string
+
+		BeforeEach(func() {
+			envVars = []string{"a=override"}
+			envFile = filepath.Join(GinkgoT().TempDir(), ".env")
+			err := os.WriteFile(envFile, []byte("# comment\na=b\nexport hello=\"world wide\"\n"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+		})
+		It("Sets env vars from file, with flags taking precedence", func() {
+			e.Opts.FromFile = envFile
+			expectedVars := map[string]string{"a": "override", "hello": "world wide"}
+			mockClient.EXPECT().SetEnvVarOnWorkspace(wsId, expectedVars).Return(nil)
+
+			err := e.SetEnvironmentVariables(mockClient)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("No env vars", func() {
+		BeforeEach(func() {
+			envVars = []string{}
+		})
+		It("fails without calling the API", func() {
+			err := e.SetEnvironmentVariables(mockClient)
+			Expect(err).To(MatchError("no environment variables given, use --env-var or --from-file"))
+		})
+	})
+
 	Context("Malformed env vars", func() {
 		BeforeEach(func() {
 			envVars = []string{"helloworld", "a=b"}
@@ -0,0 +1,224 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	goio "io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type CreateServiceCmd struct {
+	cmd           *cobra.Command
+	Opts          CreateServiceOpts
+	SecretSource  ServiceSecretSource
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type CreateServiceOpts struct {
+	shared.RootOptions
+	Name            string
+	Provider        string
+	ProviderVersion string
+	PlanId          int
+	PlanParameters  []string
+	Config          []string
+	Secrets         []string
+}
+
+func AddCreateServiceCmd(create *cobra.Command, opts shared.RootOptions) {
+	s := CreateServiceCmd{
+		cmd: &cobra.Command{
+			Use:   "service",
+			Short: "Create managed service",
+			Long: io.Long(`Create a managed service, e.g. a Postgres database, in a team.
+
+				Use 'list service-providers' to find available providers and plans.
+				Config values are parsed as JSON if possible (e.g. numbers and booleans), otherwise they are passed as string.
+				Secret values are always passed as string, use key=@path to read a secret from a file or key=- to read it from stdin.`),
+			Example: io.FormatExampleCommands("create service", []io.Example{
+				{Cmd: "-t <team-id> -n my-db -p postgres --plan 0", Desc: "Create a postgres database"},
+				{Cmd: "-t <team-id> -n my-db -p postgres --plan 0 --config max_connections=50 --secret password=@password.txt", Desc: "Create a postgres database with custom config and a secret read from a file"},
+				{Cmd: "-t <team-id> -n cache -p redis --provider-version v1 --plan 1 --plan-param storage=10", Desc: "Create a redis cache with a specific provider version and plan parameter"},
+			}),
+		},
+		Opts:          CreateServiceOpts{RootOptions: opts},
+		SecretSource:  NewServiceSecretSource(),
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	s.cmd.Flags().StringVarP(&s.Opts.Name, "name", "n", "", "Service name")
+	s.cmd.Flags().StringVarP(&s.Opts.Provider, "provider", "p", "", "Name of the service provider, e.g. postgres")
+	s.cmd.Flags().StringVar(&s.Opts.ProviderVersion, "provider-version", "", "Version of the service provider (default: latest)")
+	s.cmd.Flags().IntVar(&s.Opts.PlanId, "plan", -1, "Plan ID of the service provider")
+	s.cmd.Flags().StringArrayVar(&s.Opts.PlanParameters, "plan-param", []string{}, "Plan parameter in form key=value, can be specified multiple times")
+	s.cmd.Flags().StringArrayVar(&s.Opts.Config, "config", []string{}, "Service config in form key=value, can be specified multiple times")
+	s.cmd.Flags().StringArrayVar(&s.Opts.Secrets, "secret", []string{}, "Service secret in form key=value, key=@path or key=- to read the value from a file or stdin, can be specified multiple times")
+	_ = s.cmd.MarkFlagRequired("name")
+	_ = s.cmd.MarkFlagRequired("provider")
+	_ = s.cmd.MarkFlagRequired("plan")
+	s.cmd.RunE = s.RunE
+	shared.AddCmd(create, s.cmd)
+}
+
+func (c *CreateServiceCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	service, err := c.CreateService(client, teamId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Managed service '%s' created: %s\n", service.Name, service.Id)
+	return nil
+}
+
+func (c *CreateServiceCmd) CreateService(client Client, teamId int) (*api.ManagedService, error) {
+	if c.Opts.Name == "" {
+		return nil, errors.New("service name cannot be empty")
+	}
+
+	args, err := c.buildCreateArgs(teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := client.CreateManagedService(args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create managed service: %w", err)
+	}
+	return service, nil
+}
+
+func (c *CreateServiceCmd) buildCreateArgs(teamId int) (api.CreateManagedServiceArgs, error) {
+	planParams, err := ParseServicePlanParameters(c.Opts.PlanParameters)
+	if err != nil {
+		return api.CreateManagedServiceArgs{}, fmt.Errorf("failed to parse plan parameters: %w", err)
+	}
+	config, err := ParseServiceValues(c.Opts.Config)
+	if err != nil {
+		return api.CreateManagedServiceArgs{}, fmt.Errorf("failed to parse config: %w", err)
+	}
+	secrets, err := c.SecretSource.ParseSecrets(c.Opts.Secrets)
+	if err != nil {
+		return api.CreateManagedServiceArgs{}, fmt.Errorf("failed to parse secrets: %w", err)
+	}
+
+	provider := openapi_client.ManagedServicesCreateRequestProvider{Name: c.Opts.Provider}
+	if c.Opts.ProviderVersion != "" {
+		provider.Version = &c.Opts.ProviderVersion
+	}
+
+	return api.CreateManagedServiceArgs{
+		TeamId:   teamId,
+		Name:     c.Opts.Name,
+		Provider: provider,
+		Plan: api.ManagedServicePlan{
+			Id:         c.Opts.PlanId,
+			Parameters: planParams,
+		},
+		Config:  config,
+		Secrets: secrets,
+	}, nil
+}
+
+// ParseServicePlanParameters parses a string slice like ["storage=10"] into a map[string]int
+func ParseServicePlanParameters(params []string) (map[string]int, error) {
+	res := map[string]int{}
+	for _, p := range params {
+		key, value, found := strings.Cut(p, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid format '%s', expected 'key=value'", p)
+		}
+		v, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for plan parameter '%s': %w", value, key, err)
+		}
+		res[key] = v
+	}
+	return res, nil
+}
+
+// ServiceSecretSource reads values of service secrets from files or stdin instead of command line arguments.
+type ServiceSecretSource struct {
+	Stdin    goio.Reader
+	ReadFile func(name string) ([]byte, error)
+}
+
+func NewServiceSecretSource() ServiceSecretSource {
+	return ServiceSecretSource{
+		Stdin:    os.Stdin,
+		ReadFile: os.ReadFile,
+	}
+}
+
+// ParseSecrets parses a string slice like ["password=@password.txt"] into a map.
+// Values are always used as string, never parsed as JSON. A value @path is read from the file unchanged,
+// a value - is read from stdin with a single trailing newline removed, which is possible for one secret only.
+func (s ServiceSecretSource) ParseSecrets(values []string) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	stdinKey := ""
+	for _, v := range values {
+		key, value, found := strings.Cut(v, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid format '%s', expected 'key=value'", v)
+		}
+		switch {
+		case value == "-":
+			if stdinKey != "" {
+				return nil, fmt.Errorf("secrets %s and %s can't both be read from stdin", stdinKey, key)
+			}
+			stdinKey = key
+			content, err := goio.ReadAll(s.Stdin)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret %s from stdin: %w", key, err)
+			}
+			value = strings.TrimSuffix(strings.TrimSuffix(string(content), "\n"), "\r")
+		case strings.HasPrefix(value, "@"):
+			content, err := s.ReadFile(strings.TrimPrefix(value, "@"))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read secret %s from file: %w", key, err)
+			}
+			value = string(content)
+		}
+		res[key] = value
+	}
+	return res, nil
+}
+
+// ParseServiceValues parses a string slice like ["foo=bar", "max=5"] into a map.
+// Values are unmarshalled as JSON if possible, otherwise they are used as plain string.
+func ParseServiceValues(values []string) (map[string]interface{}, error) {
+	res := map[string]interface{}{}
+	for _, v := range values {
+		key, value, found := strings.Cut(v, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid format '%s', expected 'key=value'", v)
+		}
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			parsed = value
+		}
+		res[key] = parsed
+	}
+	return res, nil
+}
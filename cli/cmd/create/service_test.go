@@ -0,0 +1,143 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package create_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	createcmd "github.com/codesphere-cloud/cs-go/cli/cmd/create"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("CreateService", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *createcmd.CreateServiceCmd
+		teamId     int
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		teamId = 42
+		c = &createcmd.CreateServiceCmd{
+			Opts: createcmd.CreateServiceOpts{
+				RootOptions: &cmd.GlobalOptions{TeamId: teamId},
+				Name:        "my-db",
+				Provider:    "postgres",
+				PlanId:      1,
+			},
+		}
+	})
+
+	It("creates the service with config, secrets and plan parameters", func() {
+		version := "v2"
+		c.Opts.ProviderVersion = version
+		c.Opts.PlanParameters = []string{"storage=10"}
+		c.Opts.Config = []string{"max_connections=50", "mode=fast", "url=a=b"}
+		c.Opts.Secrets = []string{"password=s3cr3t"}
+
+		expectedArgs := api.CreateManagedServiceArgs{
+			TeamId:   teamId,
+			Name:     "my-db",
+			Provider: openapi_client.ManagedServicesCreateRequestProvider{Name: "postgres", Version: &version},
+			Plan: api.ManagedServicePlan{
+				Id:         1,
+				Parameters: map[string]int{"storage": 10},
+			},
+			Config:  map[string]interface{}{"max_connections": float64(50), "mode": "fast", "url": "a=b"},
+			Secrets: map[string]interface{}{"password": "s3cr3t"},
+		}
+		mockClient.EXPECT().CreateManagedService(expectedArgs).Return(&api.ManagedService{Id: "fake-id", Name: "my-db"}, nil)
+
+		service, err := c.CreateService(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(service.Id).To(Equal("fake-id"))
+	})
+
+	It("fails on invalid plan parameters", func() {
+		c.Opts.PlanParameters = []string{"storage=ten"}
+
+		_, err := c.CreateService(mockClient, teamId)
+		Expect(err).To(MatchError(ContainSubstring("failed to parse plan parameters")))
+	})
+
+	It("fails on invalid config", func() {
+		c.Opts.Config = []string{"no-value"}
+
+		_, err := c.CreateService(mockClient, teamId)
+		Expect(err).To(MatchError(ContainSubstring("failed to parse config")))
+	})
+
+	It("fails on invalid secrets", func() {
+		c.Opts.Secrets = []string{"no-value"}
+
+		_, err := c.CreateService(mockClient, teamId)
+		Expect(err).To(MatchError(ContainSubstring("failed to parse secrets")))
+	})
+
+	It("returns API errors", func() {
+		mockClient.EXPECT().CreateManagedService(mock.Anything).Return(nil, errors.New("quota exceeded"))
+
+		_, err := c.CreateService(mockClient, teamId)
+		Expect(err).To(MatchError("failed to create managed service: quota exceeded"))
+	})
+})
+
+var _ = Describe("ServiceSecretSource", func() {
+	var (
+		source createcmd.ServiceSecretSource
+		files  map[string]string
+	)
+
+	BeforeEach(func() {
+		files = map[string]string{}
+		source = createcmd.ServiceSecretSource{
+			Stdin: strings.NewReader("from-stdin\n"),
+			ReadFile: func(name string) ([]byte, error) {
+				content, ok := files[name]
+				if !ok {
+					return nil, os.ErrNotExist
+				}
+				return []byte(content), nil
+			},
+		}
+	})
+
+	It("keeps values looking like JSON as string", func() {
+		secrets, err := source.ParseSecrets([]string{"password=123456", "pin=1e3", "flag=true", "empty=null", "obj={\"a\":1}"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(Equal(map[string]interface{}{
+			"password": "123456",
+			"pin":      "1e3",
+			"flag":     "true",
+			"empty":    "null",
+			"obj":      `{"a":1}`,
+		}))
+	})
+
+	It("reads values from files and stdin", func() {
+		files["password.txt"] = "from-file"
+
+		secrets, err := source.ParseSecrets([]string{"password=@password.txt", "token=-"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secrets).To(Equal(map[string]interface{}{"password": "from-file", "token": "from-stdin"}))
+	})
+
+	It("fails on missing files", func() {
+		_, err := source.ParseSecrets([]string{"password=@missing.txt"})
+		Expect(err).To(MatchError(ContainSubstring("failed to read secret password from file")))
+	})
+
+	It("reads only one secret from stdin", func() {
+		_, err := source.ParseSecrets([]string{"password=-", "token=-"})
+		Expect(err).To(MatchError("secrets password and token can't both be read from stdin"))
+	})
+})
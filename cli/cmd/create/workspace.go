@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"os"
 	"slices"
 	"strings"
@@ -35,6 +36,11 @@ type CreateWorkspaceOpts struct {
 	Branch          *string
 	Baseimage       *string
 	PublicDevDomain *bool
+	From            *int
+	GitRef          *string
+	CloneDepth      *int
+	SyncLandscape   *bool
+	Profile         *string
 }
 
 func (c *CreateWorkspaceCmd) RunE(_ *cobra.Command, args []string) error {
@@ -73,6 +79,9 @@ func (c *CreateWorkspaceCmd) RunE(_ *cobra.Command, args []string) error {
 	log.Printf("Team ID: %d\n", ws.TeamId)
 	log.Printf("Git Repository: %s\n", giturl)
 	log.Printf("Branch: %s\n", branch)
+	if c.Opts.From != nil && *c.Opts.From > 0 {
+		log.Printf("Cloned from workspace: %d\n", *c.Opts.From)
+	}
 	log.Printf("To open it in the Codesphere IDE run '%s open workspace -w %d'", os.Args[0], ws.Id)
 
 	return nil
@@ -90,6 +99,11 @@ func AddCreateWorkspaceCmd(create *cobra.Command, opts shared.RootOptions) {
 				Environment variables can be set to initialize the workspace with a specific environment.
 				The command will wait for the workspace to become running or a timeout is reached.
 
+				With --from an existing workspace is cloned, including its environment variables.
+				Plan, base image, VPN config and restriction of the source workspace are used unless set explicitly,
+				environment variables passed with --env take precedence over the ones of the source workspace.
+				Use --sync-landscape to also deploy the landscape of the new workspace once it is running.
+
 				To decide which plan suits your needs, run 'cs list plans'
 			`),
 			Example: io.FormatExampleCommands("create workspace my-workspace", []io.Example{
@@ -101,6 +115,9 @@ func AddCreateWorkspaceCmd(create *cobra.Command, opts shared.RootOptions) {
 				{Cmd: "-r https://github.com/codesphere-cloud/landingpage-temp.git --timeout 30s", Desc: "Create a workspace and wait 30 seconds for it to become running"},
 				{Cmd: "-r https://github.com/codesphere-cloud/landingpage-temp.git -b staging", Desc: "Create a workspace from branch 'staging'"},
 				{Cmd: "-r https://github.com/my-org/my-private-project.git -P", Desc: "Create a workspace from a private git repository"},
+				{Cmd: "-r https://github.com/my-org/monorepo.git --git-ref v1.2.0 --clone-depth 1", Desc: "Create a workspace from a shallow clone of tag v1.2.0"},
+				{Cmd: "--from <workspace-id>", Desc: "Clone an existing workspace including its environment variables"},
+				{Cmd: "--from <workspace-id> --sync-landscape --landscape-profile prod", Desc: "Clone an existing workspace and deploy the landscape of its prod profile"},
 			}),
 		},
 		Opts: CreateWorkspaceOpts{RootOptions: opts},
@@ -114,6 +131,15 @@ func AddCreateWorkspaceCmd(create *cobra.Command, opts shared.RootOptions) {
 	workspace.Opts.Branch = workspace.cmd.Flags().StringP("branch", "b", "", "branch to check out")
 	workspace.Opts.Baseimage = workspace.cmd.Flags().String("base-image", "", "Base image to use for the workspace, e.g. 'ubuntu-24.04'")
 	workspace.Opts.PublicDevDomain = workspace.cmd.Flags().Bool("public-dev-domain", false, "Whether to create enable a public development domain (defaults to the public api default)")
+	workspace.Opts.From = workspace.cmd.Flags().Int("from", 0, "ID of an existing workspace to clone")
+	workspace.Opts.GitRef = workspace.cmd.Flags().String("git-ref", "", "Git ref (e.g. tag or commit) to check out")
+	workspace.Opts.CloneDepth = workspace.cmd.Flags().Int("clone-depth", 0, "Create a shallow clone with the given number of commits (defaults to the full history)")
+	workspace.Opts.SyncLandscape = workspace.cmd.Flags().Bool("sync-landscape", false, "Deploy the landscape after the workspace is running")
+	workspace.Opts.Profile = workspace.cmd.Flags().String("landscape-profile", "", "CI profile of the landscape to deploy with --sync-landscape (e.g. 'prod' for 'ci.prod.yml'), defaults to the ci.yml profile")
+	_ = workspace.cmd.RegisterFlagCompletionFunc("plan", shared.CompletePlans(opts))
+	_ = workspace.cmd.RegisterFlagCompletionFunc("base-image", shared.CompleteBaseimages(opts))
+	_ = workspace.cmd.RegisterFlagCompletionFunc("from", shared.CompleteWorkspaces(opts))
+	_ = workspace.cmd.RegisterFlagCompletionFunc("landscape-profile", shared.CompleteProfiles)
 
 	shared.AddCmd(create, workspace.cmd)
 	workspace.cmd.RunE = workspace.RunE
@@ -125,6 +151,14 @@ func (c *CreateWorkspaceCmd) CreateWorkspace(client Client, teamId int, wsName s
 		return nil, fmt.Errorf("failed to parse environment variables: %w", err)
 	}
 
+	var source *api.Workspace
+	if c.Opts.From != nil && *c.Opts.From > 0 {
+		source, envVars, err = c.getSourceWorkspace(client, *c.Opts.From, envVars)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	args := api.DeployWorkspaceArgs{
 		TeamId:  teamId,
 		PlanId:  *c.Opts.Plan,
@@ -175,10 +209,79 @@ func (c *CreateWorkspaceCmd) CreateWorkspace(client Client, teamId int, wsName s
 		args.Restricted = &public
 	}
 
+	if c.Opts.GitRef != nil && *c.Opts.GitRef != "" {
+		args.GitRef = c.Opts.GitRef
+	}
+
+	if c.Opts.CloneDepth != nil && *c.Opts.CloneDepth > 0 {
+		args.CloneDepth = c.Opts.CloneDepth
+	}
+
+	if source != nil {
+		c.applySourceDefaults(&args, source)
+	}
+
 	ws, err := client.DeployWorkspace(args)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create workspace: %w", err)
 	}
 
+	if c.Opts.SyncLandscape != nil && *c.Opts.SyncLandscape {
+		profile := ""
+		if c.Opts.Profile != nil {
+			profile = *c.Opts.Profile
+		}
+		err = client.DeployLandscape(ws.Id, profile)
+		if err != nil {
+			return ws, fmt.Errorf("failed to sync landscape of workspace %d: %w", ws.Id, err)
+		}
+		log.Printf("Landscape synced for workspace %d\n", ws.Id)
+	}
+
 	return ws, nil
 }
+
+// getSourceWorkspace fetches the workspace to clone and merges its environment variables with the given ones.
+// The given environment variables take precedence.
+func (c *CreateWorkspaceCmd) getSourceWorkspace(client Client, sourceId int, envVars map[string]string) (*api.Workspace, map[string]string, error) {
+	source, err := client.GetWorkspace(sourceId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get source workspace %d: %w", sourceId, err)
+	}
+
+	sourceVars, err := client.ListEnvVars(sourceId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list environment variables of source workspace %d: %w", sourceId, err)
+	}
+
+	merged := make(map[string]string, len(sourceVars)+len(envVars))
+	for _, v := range sourceVars {
+		merged[v.Name] = v.Value
+	}
+	maps.Copy(merged, envVars)
+	return &source, merged, nil
+}
+
+// applySourceDefaults uses the settings of the source workspace for all values not set explicitly.
+func (c *CreateWorkspaceCmd) applySourceDefaults(args *api.DeployWorkspaceArgs, source *api.Workspace) {
+	args.SourceWorkspaceId = &source.Id
+	args.IsPrivateRepo = args.IsPrivateRepo || source.IsPrivateRepo
+
+	if !c.flagChanged("plan") {
+		args.PlanId = source.PlanId
+	}
+	if args.BaseImage == nil {
+		args.BaseImage = source.BaseImage
+	}
+	if args.VpnConfigName == nil {
+		args.VpnConfigName = source.VpnConfig.Get()
+	}
+	if args.Restricted == nil {
+		restricted := source.Restricted
+		args.Restricted = &restricted
+	}
+}
+
+func (c *CreateWorkspaceCmd) flagChanged(name string) bool {
+	return c.cmd != nil && c.cmd.Flag(name).Changed
+}
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/codesphere-cloud/cs-go/api"
 	"github.com/codesphere-cloud/cs-go/cli/cmd"
@@ -114,6 +115,11 @@ var _ = Describe("CreateWorkspace", func() {
 				"--branch", "develop",
 				"--base-image", "ubuntu-24.04",
 				"--public-dev-domain=false",
+				"--from", "42",
+				"--git-ref", "main",
+				"--clone-depth", "1",
+				"--sync-landscape",
+				"--landscape-profile", "prod",
 			})
 
 			// Override RunE to avoid actually creating workspace
@@ -171,6 +177,88 @@ var _ = Describe("CreateWorkspace", func() {
 		})
 	})
 
+	Context("Cloning from a source workspace", func() {
+		var (
+			sourceId  int
+			sourceImg string
+		)
+
+		BeforeEach(func() {
+			env = []string{"FOO=override"}
+		})
+
+		JustBeforeEach(func() {
+			sourceId = 7
+			sourceImg = "ubuntu-22.04"
+			c.Opts.From = &sourceId
+		})
+
+		It("uses settings and env vars of the source workspace", func() {
+			mockClient.EXPECT().GetWorkspace(sourceId).Return(api.Workspace{
+				Id:         sourceId,
+				PlanId:     21,
+				BaseImage:  &sourceImg,
+				Restricted: true,
+			}, nil)
+			mockClient.EXPECT().ListEnvVars(sourceId).Return([]api.EnvVar{
+				{Name: "FOO", Value: "bar"},
+				{Name: "BAZ", Value: "qux"},
+			}, nil)
+
+			restricted := true
+			expectedArgs := deployArgs
+			expectedArgs.EnvVars = map[string]string{"FOO": "override", "BAZ": "qux"}
+			expectedArgs.PlanId = 21
+			expectedArgs.BaseImage = &sourceImg
+			expectedArgs.Restricted = &restricted
+			expectedArgs.SourceWorkspaceId = &sourceId
+			mockClient.EXPECT().DeployWorkspace(expectedArgs).Return(&api.Workspace{Id: 8, Name: wsName}, nil)
+
+			ws, err := c.CreateWorkspace(mockClient, teamId, wsName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ws.Id).To(Equal(8))
+		})
+
+		It("syncs the landscape of the given profile", func() {
+			syncLandscape := true
+			profile := "prod"
+			c.Opts.SyncLandscape = &syncLandscape
+			c.Opts.Profile = &profile
+
+			mockClient.EXPECT().GetWorkspace(sourceId).Return(api.Workspace{Id: sourceId, PlanId: plan}, nil)
+			mockClient.EXPECT().ListEnvVars(sourceId).Return([]api.EnvVar{}, nil)
+			mockClient.EXPECT().DeployWorkspace(mock.Anything).Return(&api.Workspace{Id: 8, Name: wsName}, nil)
+			mockClient.EXPECT().DeployLandscape(8, "prod").Return(nil)
+
+			_, err := c.CreateWorkspace(mockClient, teamId, wsName)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails when the source workspace can't be fetched", func() {
+			mockClient.EXPECT().GetWorkspace(sourceId).Return(api.Workspace{}, errors.New("not found"))
+
+			_, err := c.CreateWorkspace(mockClient, teamId, wsName)
+			Expect(err).To(MatchError("failed to get source workspace 7: not found"))
+		})
+	})
+
+	Context("Git ref and clone depth are set", func() {
+		It("passes them to the workspace creation", func() {
+			gitRef := "v1.2.0"
+			depth := 1
+			c.Opts.GitRef = &gitRef
+			c.Opts.CloneDepth = &depth
+
+			expectedArgs := deployArgs
+			expectedArgs.GitRef = &gitRef
+			expectedArgs.CloneDepth = &depth
+			mockClient.EXPECT().DeployWorkspace(expectedArgs).Return(&api.Workspace{Name: wsName}, nil)
+
+			_, err := c.CreateWorkspace(mockClient, teamId, wsName)
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
 	Context("Repository URL validation", func() {
 		It("validates and sets repository URL when provided", func() {
 			repoUrl := "https://github.com/test/repo.git"
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
 	io_pkg "github.com/codesphere-cloud/cs-go/pkg/io"
 	"github.com/spf13/cobra"
 )
@@ -58,7 +59,7 @@ func (c *CurlCmd) RunE(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get workspace ID: %w", err)
 	}
 
-	token, err := c.Opts.Env.GetApiToken()
+	token, err := c.Opts.GetApiToken()
 	if err != nil {
 		return fmt.Errorf("failed to get API token: %w", err)
 	}
@@ -129,7 +130,7 @@ func (c *CurlCmd) CurlWorkspace(client Client, wsId int, token string, path stri
 
 	output, err := c.Opts.Executor.Execute(ctx, cmdArgs[0], cmdArgs[1:], os.Stdout, os.Stderr)
 	if err != nil && err == context.DeadlineExceeded {
-		return fmt.Errorf("timeout exceeded while requesting workspace %d", wsId)
+		return fmt.Errorf("%w while requesting workspace %d", cserrors.ErrTimedOut, wsId)
 	}
 
 	if err != nil {
@@ -10,4 +10,12 @@ type Client interface {
 	DeleteWorkspace(wsId int) error
 	DeleteTeam(teamId int) error
 	RemoveTeamMember(teamId int, userId int) error
+	ListManagedServices(teamId int) ([]api.ManagedService, error)
+	DeleteManagedService(serviceId string) error
+	ListSshKeys() ([]api.SshKey, error)
+	DeleteSshKey(fingerprint string) error
+	GetDomain(teamId int, domainName string) (*api.Domain, error)
+	DeleteDomain(teamId int, domainName string) error
+	DeleteEnvVars(workspaceId int, names []string) error
+	RemoveOrgMember(orgId string, userId int) error
 }
@@ -25,4 +25,9 @@ func AddDeleteCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
 	AddDeleteWorkspaceCmd(delete.cmd, opts)
 	AddDeleteTeamCmd(delete.cmd, opts)
 	AddDeleteTeamMemberCmd(delete.cmd, opts)
+	AddDeleteServiceCmd(delete.cmd, opts)
+	AddDeleteSshKeyCmd(delete.cmd, opts)
+	AddDeleteDomainCmd(delete.cmd, opts)
+	AddDeleteEnvCmd(delete.cmd, opts)
+	AddDeleteOrgMemberCmd(delete.cmd, opts)
 }
@@ -0,0 +1,89 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type DeleteDomainCmd struct {
+	cmd           *cobra.Command
+	Opts          DeleteDomainOpts
+	Prompt        Prompt
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type DeleteDomainOpts struct {
+	shared.RootOptions
+	Name      string
+	Confirmed bool
+}
+
+func AddDeleteDomainCmd(delete *cobra.Command, opts shared.RootOptions) {
+	d := DeleteDomainCmd{
+		cmd: &cobra.Command{
+			Use:   "domain",
+			Short: "Delete custom domain",
+			Long: io.Long(`Delete a custom domain after confirmation.
+
+			Confirmation can be given interactively or with the --yes flag`),
+			Example: io.FormatExampleCommands("delete domain", []io.Example{
+				{Cmd: "-t <team-id> -n app.example.com", Desc: "Delete a custom domain after interactive confirmation"},
+				{Cmd: "-t <team-id> -n app.example.com --yes", Desc: "Delete a custom domain without confirmation"},
+			}),
+		},
+		Opts:          DeleteDomainOpts{RootOptions: opts},
+		Prompt:        &io.Prompt{},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	d.cmd.Flags().StringVarP(&d.Opts.Name, "name", "n", "", "Domain name")
+	d.cmd.Flags().BoolVar(&d.Opts.Confirmed, "yes", false, "Confirm deletion of domain")
+	_ = d.cmd.MarkFlagRequired("name")
+	d.cmd.RunE = d.RunE
+	shared.AddCmd(delete, d.cmd)
+}
+
+func (c *DeleteDomainCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.DeleteDomain(client, teamId)
+}
+
+func (c *DeleteDomainCmd) DeleteDomain(client Client, teamId int) error {
+	domain, err := client.GetDomain(teamId, c.Opts.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get domain %s: %w", c.Opts.Name, err)
+	}
+
+	if !c.Opts.Confirmed {
+		log.Printf("Please confirm deletion of domain '%s' in team %d, connected to %d path(s), by entering its name:\n", domain.Name, teamId, len(domain.Workspaces))
+		confirmation := c.Prompt.InputPrompt("Confirmation delete")
+
+		if confirmation != domain.Name {
+			return errors.New("confirmation failed")
+		}
+	}
+
+	err = client.DeleteDomain(teamId, domain.Name)
+	if err != nil {
+		return fmt.Errorf("failed to delete domain: %w", err)
+	}
+
+	log.Printf("Domain %s deleted successfully\n", domain.Name)
+	return nil
+}
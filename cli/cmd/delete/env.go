@@ -0,0 +1,63 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type DeleteEnvCmd struct {
+	cmd           *cobra.Command
+	Opts          shared.RootOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddDeleteEnvCmd(delete *cobra.Command, opts shared.RootOptions) {
+	e := DeleteEnvCmd{
+		cmd: &cobra.Command{
+			Use:   "env KEY...",
+			Short: "Delete environment variables",
+			Long:  `Delete environment variables from a workspace`,
+			Example: io.FormatExampleCommands("delete env", []io.Example{
+				{Cmd: "-w <workspace-id> FOO", Desc: "Delete a single environment variable"},
+				{Cmd: "-w <workspace-id> FOO BAR", Desc: "Delete multiple environment variables"},
+			}),
+			Args: cobra.MinimumNArgs(1),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	e.cmd.RunE = e.RunE
+	shared.AddCmd(delete, e.cmd)
+}
+
+func (c *DeleteEnvCmd) RunE(_ *cobra.Command, args []string) error {
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.DeleteEnv(client, wsId, args)
+}
+
+func (c *DeleteEnvCmd) DeleteEnv(client Client, wsId int, names []string) error {
+	err := client.DeleteEnvVars(wsId, names)
+	if err != nil {
+		return fmt.Errorf("failed to delete environment variables: %w", err)
+	}
+
+	log.Printf("Environment variables %s deleted from workspace %d\n", strings.Join(names, ", "), wsId)
+	return nil
+}
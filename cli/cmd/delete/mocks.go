@@ -36,6 +36,222 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
+// DeleteDomain provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteDomain(teamId int, domainName string) error {
+	ret := _mock.Called(teamId, domainName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDomain")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(teamId, domainName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDomain'
+type MockClient_DeleteDomain_Call struct {
+	*mock.Call
+}
+
+// DeleteDomain is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+func (_e *MockClient_Expecter) DeleteDomain(teamId any, domainName any) *MockClient_DeleteDomain_Call {
+	return &MockClient_DeleteDomain_Call{Call: _e.mock.On("DeleteDomain", teamId, domainName)}
+}
+
+func (_c *MockClient_DeleteDomain_Call) Run(run func(teamId int, domainName string)) *MockClient_DeleteDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteDomain_Call) Return(err error) *MockClient_DeleteDomain_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteDomain_Call) RunAndReturn(run func(teamId int, domainName string) error) *MockClient_DeleteDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteEnvVars provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteEnvVars(workspaceId int, names []string) error {
+	ret := _mock.Called(workspaceId, names)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEnvVars")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, []string) error); ok {
+		r0 = returnFunc(workspaceId, names)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteEnvVars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvVars'
+type MockClient_DeleteEnvVars_Call struct {
+	*mock.Call
+}
+
+// DeleteEnvVars is a helper method to define mock.On call
+//   - workspaceId int
+//   - names []string
+func (_e *MockClient_Expecter) DeleteEnvVars(workspaceId any, names any) *MockClient_DeleteEnvVars_Call {
+	return &MockClient_DeleteEnvVars_Call{Call: _e.mock.On("DeleteEnvVars", workspaceId, names)}
+}
+
+func (_c *MockClient_DeleteEnvVars_Call) Run(run func(workspaceId int, names []string)) *MockClient_DeleteEnvVars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteEnvVars_Call) Return(err error) *MockClient_DeleteEnvVars_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteEnvVars_Call) RunAndReturn(run func(workspaceId int, names []string) error) *MockClient_DeleteEnvVars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteManagedService provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteManagedService(serviceId string) error {
+	ret := _mock.Called(serviceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteManagedService")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(serviceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteManagedService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteManagedService'
+type MockClient_DeleteManagedService_Call struct {
+	*mock.Call
+}
+
+// DeleteManagedService is a helper method to define mock.On call
+//   - serviceId string
+func (_e *MockClient_Expecter) DeleteManagedService(serviceId any) *MockClient_DeleteManagedService_Call {
+	return &MockClient_DeleteManagedService_Call{Call: _e.mock.On("DeleteManagedService", serviceId)}
+}
+
+func (_c *MockClient_DeleteManagedService_Call) Run(run func(serviceId string)) *MockClient_DeleteManagedService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteManagedService_Call) Return(err error) *MockClient_DeleteManagedService_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteManagedService_Call) RunAndReturn(run func(serviceId string) error) *MockClient_DeleteManagedService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSshKey provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSshKey(fingerprint string) error {
+	ret := _mock.Called(fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSshKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(fingerprint)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSshKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSshKey'
+type MockClient_DeleteSshKey_Call struct {
+	*mock.Call
+}
+
+// DeleteSshKey is a helper method to define mock.On call
+//   - fingerprint string
+func (_e *MockClient_Expecter) DeleteSshKey(fingerprint any) *MockClient_DeleteSshKey_Call {
+	return &MockClient_DeleteSshKey_Call{Call: _e.mock.On("DeleteSshKey", fingerprint)}
+}
+
+func (_c *MockClient_DeleteSshKey_Call) Run(run func(fingerprint string)) *MockClient_DeleteSshKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSshKey_Call) Return(err error) *MockClient_DeleteSshKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSshKey_Call) RunAndReturn(run func(fingerprint string) error) *MockClient_DeleteSshKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteTeam provides a mock function for the type MockClient
 func (_mock *MockClient) DeleteTeam(teamId int) error {
 	ret := _mock.Called(teamId)
@@ -138,6 +354,74 @@ func (_c *MockClient_DeleteWorkspace_Call) RunAndReturn(run func(wsId int) error
 	return _c
 }
 
+// GetDomain provides a mock function for the type MockClient
+func (_mock *MockClient) GetDomain(teamId int, domainName string) (*api.Domain, error) {
+	ret := _mock.Called(teamId, domainName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDomain")
+	}
+
+	var r0 *api.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (*api.Domain, error)); ok {
+		return returnFunc(teamId, domainName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) *api.Domain); ok {
+		r0 = returnFunc(teamId, domainName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Domain)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(teamId, domainName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDomain'
+type MockClient_GetDomain_Call struct {
+	*mock.Call
+}
+
+// GetDomain is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+func (_e *MockClient_Expecter) GetDomain(teamId any, domainName any) *MockClient_GetDomain_Call {
+	return &MockClient_GetDomain_Call{Call: _e.mock.On("GetDomain", teamId, domainName)}
+}
+
+func (_c *MockClient_GetDomain_Call) Run(run func(teamId int, domainName string)) *MockClient_GetDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetDomain_Call) Return(v *api.Domain, err error) *MockClient_GetDomain_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_GetDomain_Call) RunAndReturn(run func(teamId int, domainName string) (*api.Domain, error)) *MockClient_GetDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetWorkspace provides a mock function for the type MockClient
 func (_mock *MockClient) GetWorkspace(workspaceId int) (api.Workspace, error) {
 	ret := _mock.Called(workspaceId)
@@ -198,6 +482,180 @@ func (_c *MockClient_GetWorkspace_Call) RunAndReturn(run func(workspaceId int) (
 	return _c
 }
 
+// ListManagedServices provides a mock function for the type MockClient
+func (_mock *MockClient) ListManagedServices(teamId int) ([]api.ManagedService, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListManagedServices")
+	}
+
+	var r0 []api.ManagedService
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.ManagedService, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.ManagedService); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.ManagedService)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListManagedServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListManagedServices'
+type MockClient_ListManagedServices_Call struct {
+	*mock.Call
+}
+
+// ListManagedServices is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListManagedServices(teamId any) *MockClient_ListManagedServices_Call {
+	return &MockClient_ListManagedServices_Call{Call: _e.mock.On("ListManagedServices", teamId)}
+}
+
+func (_c *MockClient_ListManagedServices_Call) Run(run func(teamId int)) *MockClient_ListManagedServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListManagedServices_Call) Return(vs []api.ManagedService, err error) *MockClient_ListManagedServices_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListManagedServices_Call) RunAndReturn(run func(teamId int) ([]api.ManagedService, error)) *MockClient_ListManagedServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSshKeys provides a mock function for the type MockClient
+func (_mock *MockClient) ListSshKeys() ([]api.SshKey, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSshKeys")
+	}
+
+	var r0 []api.SshKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]api.SshKey, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []api.SshKey); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.SshKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListSshKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSshKeys'
+type MockClient_ListSshKeys_Call struct {
+	*mock.Call
+}
+
+// ListSshKeys is a helper method to define mock.On call
+func (_e *MockClient_Expecter) ListSshKeys() *MockClient_ListSshKeys_Call {
+	return &MockClient_ListSshKeys_Call{Call: _e.mock.On("ListSshKeys")}
+}
+
+func (_c *MockClient_ListSshKeys_Call) Run(run func()) *MockClient_ListSshKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_ListSshKeys_Call) Return(vs []api.SshKey, err error) *MockClient_ListSshKeys_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListSshKeys_Call) RunAndReturn(run func() ([]api.SshKey, error)) *MockClient_ListSshKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrgMember provides a mock function for the type MockClient
+func (_mock *MockClient) RemoveOrgMember(orgId string, userId int) error {
+	ret := _mock.Called(orgId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrgMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = returnFunc(orgId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RemoveOrgMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrgMember'
+type MockClient_RemoveOrgMember_Call struct {
+	*mock.Call
+}
+
+// RemoveOrgMember is a helper method to define mock.On call
+//   - orgId string
+//   - userId int
+func (_e *MockClient_Expecter) RemoveOrgMember(orgId any, userId any) *MockClient_RemoveOrgMember_Call {
+	return &MockClient_RemoveOrgMember_Call{Call: _e.mock.On("RemoveOrgMember", orgId, userId)}
+}
+
+func (_c *MockClient_RemoveOrgMember_Call) Run(run func(orgId string, userId int)) *MockClient_RemoveOrgMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RemoveOrgMember_Call) Return(err error) *MockClient_RemoveOrgMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_RemoveOrgMember_Call) RunAndReturn(run func(orgId string, userId int) error) *MockClient_RemoveOrgMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RemoveTeamMember provides a mock function for the type MockClient
 func (_mock *MockClient) RemoveTeamMember(teamId int, userId int) error {
 	ret := _mock.Called(teamId, userId)
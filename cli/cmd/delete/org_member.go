@@ -0,0 +1,76 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type DeleteOrgMemberCmd struct {
+	cmd           *cobra.Command
+	Opts          DeleteOrgMemberOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type DeleteOrgMemberOpts struct {
+	shared.RootOptions
+	UserId int
+}
+
+func AddDeleteOrgMemberCmd(delete *cobra.Command, opts shared.RootOptions) {
+	res := DeleteOrgMemberCmd{
+		cmd: &cobra.Command{
+			Use:   "org-member",
+			Short: "Delete organization member",
+			Long: io.Long(`Delete a member from an organization.
+
+				The organization is selected with the -O/--org flag or the CS_ORG_ID environment variable.`),
+			Example: io.FormatExampleCommands("delete org-member", []io.Example{
+				{Cmd: "-O <org-id> -u <userId>", Desc: "Delete a user from an organization"},
+			}),
+		},
+		Opts: DeleteOrgMemberOpts{
+			RootOptions: opts,
+		},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	res.cmd.Flags().IntVarP(&res.Opts.UserId, "user", "u", 0, "Organization member user ID")
+	_ = res.cmd.MarkFlagRequired("user")
+	res.cmd.RunE = res.RunE
+	shared.AddCmd(delete, res.cmd)
+}
+
+func (c *DeleteOrgMemberCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	orgId, err := shared.RequireOrgId(c.Opts.RootOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.DeleteOrgMember(client, orgId, c.Opts.UserId)
+}
+
+func (c *DeleteOrgMemberCmd) DeleteOrgMember(client Client, orgId string, userId int) error {
+	if userId <= 0 {
+		return errors.New("user ID has to be set")
+	}
+
+	err := client.RemoveOrgMember(orgId, userId)
+	if err != nil {
+		return fmt.Errorf("failed to remove member from organization: %w", err)
+	}
+
+	log.Printf("User %d removed from organization %s\n", userId, orgId)
+	return nil
+}
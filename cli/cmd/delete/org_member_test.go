@@ -0,0 +1,101 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete_test
+
+import (
+	"errors"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	deletecmd "github.com/codesphere-cloud/cs-go/cli/cmd/delete"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeleteOrgMember", func() {
+	var (
+		mockEnv    *cmd.MockEnv
+		mockClient *cmd.MockClient
+		c          *deletecmd.DeleteOrgMemberCmd
+		orgId      string
+		userId     int
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		mockEnv = cmd.NewMockEnv(GinkgoT())
+		orgId = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		userId = 100
+		c = &deletecmd.DeleteOrgMemberCmd{
+			Opts: deletecmd.DeleteOrgMemberOpts{
+				RootOptions: &cmd.GlobalOptions{
+					Env:   mockEnv,
+					OrgId: orgId,
+				},
+				UserId: userId,
+			},
+			ClientFactory: func(opts shared.RootOptions) (deletecmd.Client, error) {
+				return mockClient, nil
+			},
+		}
+	})
+
+	AfterEach(func() {
+		mockEnv.AssertExpectations(GinkgoT())
+		mockClient.AssertExpectations(GinkgoT())
+	})
+
+	Context("Validation", func() {
+		It("should fail if the user ID is empty", func() {
+			err := c.DeleteOrgMember(mockClient, orgId, 0)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("user ID has to be set"))
+		})
+	})
+
+	Context("RunE execution flow", func() {
+		It("should successfully delete a member from an organization", func() {
+			mockClient.EXPECT().RemoveOrgMember(orgId, userId).Return(nil).Once()
+
+			err := c.RunE(nil, []string{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail when the token is not allowed to delete a member", func() {
+			mockClient.EXPECT().RemoveOrgMember(orgId, userId).Return(errors.New("failed")).Once()
+
+			err := c.RunE(nil, []string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to remove member from organization: "))
+		})
+
+		It("should fail when client creation fails", func() {
+			c.ClientFactory = func(opts shared.RootOptions) (deletecmd.Client, error) {
+				return nil, errors.New("client init failed")
+			}
+
+			err := c.RunE(nil, []string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to create Codesphere client: client init failed"))
+		})
+
+		It("should fail when organization ID is unavailable", func() {
+			c.Opts.RootOptions = &cmd.GlobalOptions{Env: mockEnv}
+			mockEnv.EXPECT().GetOrgId().Return("").Once()
+
+			err := c.RunE(nil, []string{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("organization ID not set, use the -O/--org flag or the CS_ORG_ID environment variable"))
+		})
+
+		It("should fail when user ID is empty", func() {
+			c.Opts.UserId = 0
+			err := c.RunE(nil, []string{})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("user ID has to be set"))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type DeleteServiceCmd struct {
+	cmd           *cobra.Command
+	Opts          DeleteServiceOpts
+	Prompt        Prompt
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type DeleteServiceOpts struct {
+	shared.RootOptions
+	ServiceId string
+	Confirmed bool
+}
+
+func AddDeleteServiceCmd(delete *cobra.Command, opts shared.RootOptions) {
+	s := DeleteServiceCmd{
+		cmd: &cobra.Command{
+			Use:   "service",
+			Short: "Delete managed service",
+			Long: io.Long(`Delete a managed service after confirmation.
+
+			Confirmation can be given interactively or with the --yes flag`),
+			Example: io.FormatExampleCommands("delete service", []io.Example{
+				{Cmd: "-t <team-id> --id <service-id>", Desc: "Delete a managed service after interactive confirmation"},
+				{Cmd: "-t <team-id> --id <service-id> --yes", Desc: "Delete a managed service without confirmation"},
+			}),
+		},
+		Opts:          DeleteServiceOpts{RootOptions: opts},
+		Prompt:        &io.Prompt{},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	s.cmd.Flags().StringVar(&s.Opts.ServiceId, "id", "", "ID of the managed service")
+	s.cmd.Flags().BoolVar(&s.Opts.Confirmed, "yes", false, "Confirm deletion of managed service")
+	_ = s.cmd.MarkFlagRequired("id")
+	s.cmd.RunE = s.RunE
+	shared.AddCmd(delete, s.cmd)
+}
+
+func (c *DeleteServiceCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.DeleteService(client, teamId)
+}
+
+func (c *DeleteServiceCmd) DeleteService(client Client, teamId int) error {
+	service, err := findManagedService(client, teamId, c.Opts.ServiceId)
+	if err != nil {
+		return err
+	}
+
+	if !c.Opts.Confirmed {
+		log.Printf("Please confirm deletion of managed service '%s', ID %s, in team %d by entering its name:\n", service.Name, service.Id, teamId)
+		confirmation := c.Prompt.InputPrompt("Confirmation delete")
+
+		if confirmation != service.Name {
+			return errors.New("confirmation failed")
+		}
+	}
+
+	err = client.DeleteManagedService(service.Id)
+	if err != nil {
+		return fmt.Errorf("failed to delete managed service: %w", err)
+	}
+
+	log.Printf("Managed service %s deleted successfully\n", service.Id)
+	return nil
+}
+
+func findManagedService(client Client, teamId int, serviceId string) (*api.ManagedService, error) {
+	services, err := client.ListManagedServices(teamId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list managed services: %w", err)
+	}
+	for _, s := range services {
+		if s.Id == serviceId {
+			return &s, nil
+		}
+	}
+	return nil, cserrors.NotFound(fmt.Sprintf("no managed service with ID %s found in team %d", serviceId, teamId))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	deletecmd "github.com/codesphere-cloud/cs-go/cli/cmd/delete"
+)
+
+var _ = Describe("DeleteService", func() {
+	var (
+		mockClient *cmd.MockClient
+		mockPrompt *deletecmd.MockPrompt
+		c          *deletecmd.DeleteServiceCmd
+		teamId     int
+		services   []api.ManagedService
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		mockPrompt = deletecmd.NewMockPrompt(GinkgoT())
+		teamId = 42
+		services = []api.ManagedService{
+			{Id: "other-id", Name: "other-db"},
+			{Id: "fake-id", Name: "my-db"},
+		}
+		c = &deletecmd.DeleteServiceCmd{
+			Opts: deletecmd.DeleteServiceOpts{
+				RootOptions: &cmd.GlobalOptions{TeamId: teamId},
+				ServiceId:   "fake-id",
+			},
+			Prompt: mockPrompt,
+		}
+	})
+
+	Context("Unconfirmed", func() {
+		It("deletes the service when its name is entered", func() {
+			mockClient.EXPECT().ListManagedServices(teamId).Return(services, nil)
+			mockPrompt.EXPECT().InputPrompt("Confirmation delete").Return("my-db")
+			mockClient.EXPECT().DeleteManagedService("fake-id").Return(nil)
+
+			err := c.DeleteService(mockClient, teamId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error when a wrong name is entered", func() {
+			mockClient.EXPECT().ListManagedServices(teamId).Return(services, nil)
+			mockPrompt.EXPECT().InputPrompt("Confirmation delete").Return("other-db")
+
+			err := c.DeleteService(mockClient, teamId)
+			Expect(err).To(MatchError("confirmation failed"))
+		})
+	})
+
+	Context("Confirmed via CLI flag", func() {
+		BeforeEach(func() {
+			c.Opts.Confirmed = true
+		})
+
+		It("deletes the service without prompting", func() {
+			mockClient.EXPECT().ListManagedServices(teamId).Return(services, nil)
+			mockClient.EXPECT().DeleteManagedService("fake-id").Return(nil)
+
+			err := c.DeleteService(mockClient, teamId)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns an error when the service does not exist", func() {
+			mockClient.EXPECT().ListManagedServices(teamId).Return(services[:1], nil)
+
+			err := c.DeleteService(mockClient, teamId)
+			Expect(err).To(MatchError("no managed service with ID fake-id found in team 42"))
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package delete
+
+import (
+	"fmt"
+	"log"
+
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type DeleteSshKeyCmd struct {
+	cmd           *cobra.Command
+	Opts          DeleteSshKeyOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type DeleteSshKeyOpts struct {
+	shared.RootOptions
+	Fingerprint string
+}
+
+func AddDeleteSshKeyCmd(delete *cobra.Command, opts shared.RootOptions) {
+	k := DeleteSshKeyCmd{
+		cmd: &cobra.Command{
+			Use:   "ssh-key",
+			Short: "Delete SSH public key",
+			Long: io.Long(`Delete an SSH public key from your Codesphere user account.
+
+				Use 'list ssh-keys' to find the fingerprint of the key.`),
+			Example: io.FormatExampleCommands("delete ssh-key", []io.Example{
+				{Cmd: "--fingerprint SHA256:abc...", Desc: "Delete the SSH key with the given fingerprint"},
+			}),
+		},
+		Opts:          DeleteSshKeyOpts{RootOptions: opts},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	k.cmd.Flags().StringVar(&k.Opts.Fingerprint, "fingerprint", "", "Fingerprint of the SSH key")
+	_ = k.cmd.MarkFlagRequired("fingerprint")
+	k.cmd.RunE = k.RunE
+	shared.AddCmd(delete, k.cmd)
+}
+
+func (c *DeleteSshKeyCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.DeleteSshKey(client)
+}
+
+func (c *DeleteSshKeyCmd) DeleteSshKey(client Client) error {
+	keys, err := client.ListSshKeys()
+	if err != nil {
+		return fmt.Errorf("failed to list SSH keys: %w", err)
+	}
+
+	found := false
+	for _, k := range keys {
+		if k.PublicKeyFingerprint == c.Opts.Fingerprint {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return cserrors.NotFound(fmt.Sprintf("no SSH key with fingerprint %s found", c.Opts.Fingerprint))
+	}
+
+	err = client.DeleteSshKey(c.Opts.Fingerprint)
+	if err != nil {
+		return fmt.Errorf("failed to delete SSH key: %w", err)
+	}
+
+	log.Printf("SSH key %s deleted successfully\n", c.Opts.Fingerprint)
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	startcmd "github.com/codesphere-cloud/cs-go/cli/cmd/start"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+// Exit codes of the CLI by error class, documented in the help of the root command.
+const (
+	ExitOK = 0
+	// Any error not covered by a more specific exit code
+	ExitError = 1
+	// Invalid arguments or flags
+	ExitUsage = 2
+	// Missing, invalid or insufficient API token
+	ExitAuth = 3
+	// Resource not found
+	ExitNotFound = 4
+	// Request conflicts with the current state of a resource
+	ExitConflict = 5
+	// Operation didn't finish in time
+	ExitTimeout = 6
+	// API is rate limited or unavailable, retrying later may succeed
+	ExitUnavailable = 7
+	// Pipeline stage failed
+	ExitPipelineFailed = 8
+)
+
+const exitCodeHelp = `Exit codes:
+  0  success
+  1  unspecified error
+  2  invalid arguments or flags
+  3  missing, invalid or insufficient API token
+  4  resource not found
+  5  conflict with the current state of a resource
+  6  timeout
+  7  API rate limited or unavailable, retrying later may succeed
+  8  pipeline stage failed`
+
+// UsageError is returned for invalid arguments or flags.
+type UsageError struct {
+	Err error
+}
+
+func (e *UsageError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *UsageError) Unwrap() error {
+	return e.Err
+}
+
+// usageErrorPrefixes are prefixes of flag validation errors which cobra returns untyped.
+var usageErrorPrefixes = []string{
+	"required flag(s)",
+	"if any flags in the group",
+	"at least one of the flags in the group",
+}
+
+// ExitCode returns the exit code for an error returned by a command.
+func ExitCode(err error) int {
+	var usageErr *UsageError
+	var stageErr *startcmd.StageFailedError
+	switch {
+	case err == nil:
+		return ExitOK
+	case errors.As(err, &usageErr), isCobraUsageError(err):
+		return ExitUsage
+	case errors.Is(err, cs.ErrNoApiToken), errors.Is(err, cserrors.ErrUnauthorized), errors.Is(err, cserrors.ErrForbidden):
+		return ExitAuth
+	case errors.Is(err, cserrors.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, cserrors.ErrConflict):
+		return ExitConflict
+	case errors.Is(err, cserrors.ErrTimedOut), errors.Is(err, context.DeadlineExceeded):
+		return ExitTimeout
+	case errors.Is(err, cserrors.ErrRateLimited), errors.Is(err, cserrors.ErrServer):
+		return ExitUnavailable
+	case errors.As(err, &stageErr):
+		return ExitPipelineFailed
+	default:
+		return ExitError
+	}
+}
+
+func isCobraUsageError(err error) bool {
+	for _, prefix := range usageErrorPrefixes {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// markUsageErrors wraps flag parsing and argument validation errors of cmd and its subcommands in a [UsageError].
+func markUsageErrors(cmd *cobra.Command) {
+	cmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return &UsageError{Err: err}
+	})
+	markArgErrors(cmd)
+}
+
+func markArgErrors(cmd *cobra.Command) {
+	// cobra only validates the arguments of runnable commands
+	if validate := cmd.Args; validate != nil && cmd.Runnable() {
+		cmd.Args = func(c *cobra.Command, args []string) error {
+			if err := validate(c, args); err != nil {
+				return &UsageError{Err: err}
+			}
+			return nil
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		markArgErrors(sub)
+	}
+}
+
+// unknownSubcommand returns a [UsageError] if args call a subcommand of a command group that doesn't exist.
+// Cobra prints the help of the group in that case and succeeds.
+// The returned command is the group the subcommand was looked up in.
+func unknownSubcommand(root *cobra.Command, args []string) (*cobra.Command, error) {
+	c, rest, err := root.Find(args)
+	if err != nil || c.Runnable() || !c.HasSubCommands() {
+		return nil, nil
+	}
+	// errors parsing the flags are reported by cobra when executing the command
+	if err := c.ParseFlags(rest); err != nil || c.Flags().NArg() == 0 {
+		return nil, nil
+	}
+	return c, &UsageError{Err: fmt.Errorf("unknown command %q for %q", c.Flags().Arg(0), c.CommandPath())}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	startcmd "github.com/codesphere-cloud/cs-go/cli/cmd/start"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+var _ = Describe("ExitCode", func() {
+	DescribeTable("maps errors to exit codes",
+		func(err error, code int) {
+			Expect(cmd.ExitCode(err)).To(Equal(code))
+		},
+		Entry("no error", nil, cmd.ExitOK),
+		Entry("generic error", errors.New("failed"), cmd.ExitError),
+		Entry("unauthorized", fmt.Errorf("failed to list teams: %w", &cserrors.APIError{StatusCode: 401}), cmd.ExitAuth),
+		Entry("forbidden", &cserrors.APIError{StatusCode: 403}, cmd.ExitAuth),
+		Entry("not found", &cserrors.APIError{StatusCode: 404}, cmd.ExitNotFound),
+		Entry("unknown name", cserrors.NotFound("no team with name x found"), cmd.ExitNotFound),
+		Entry("conflict", &cserrors.APIError{StatusCode: 409}, cmd.ExitConflict),
+		Entry("rate limited", &cserrors.APIError{StatusCode: 429}, cmd.ExitUnavailable),
+		Entry("server error", &cserrors.APIError{StatusCode: 502}, cmd.ExitUnavailable),
+		Entry("usage", &cmd.UsageError{Err: errors.New("accepts 1 arg(s), received 0")}, cmd.ExitUsage),
+		Entry("required flag", errors.New(`required flag(s) "workspace" not set`), cmd.ExitUsage),
+		Entry("missing token", fmt.Errorf("%w for https://codesphere.com/api", cs.ErrNoApiToken), cmd.ExitAuth),
+		Entry("timeout", cserrors.TimedOut("waiting for workspace 1", time.Second), cmd.ExitTimeout),
+		Entry("deadline exceeded", fmt.Errorf("failed: %w", context.DeadlineExceeded), cmd.ExitTimeout),
+		Entry("pipeline stage failed", fmt.Errorf("failed to run pipeline: %w", &startcmd.StageFailedError{Stage: "run", Err: errors.New("failure")}), cmd.ExitPipelineFailed),
+	)
+
+	Context("root command", func() {
+		var root *cobra.Command
+
+		BeforeEach(func() {
+			root = cmd.GetRootCmd()
+			root.SetOut(io.Discard)
+			root.SetErr(io.Discard)
+		})
+
+		It("returns a usage error for unknown commands", func() {
+			Expect(cmd.ExitCode(cmd.ExecuteArgs(root, []string{"does-not-exist"}))).To(Equal(cmd.ExitUsage))
+		})
+
+		It("returns a usage error for unknown subcommands of command groups", func() {
+			Expect(cmd.ExitCode(cmd.ExecuteArgs(root, []string{"list", "does-not-exist"}))).To(Equal(cmd.ExitUsage))
+		})
+
+		It("prints the help of command groups without subcommand", func() {
+			Expect(cmd.ExecuteArgs(root, []string{"list"})).To(Succeed())
+		})
+
+		It("returns a usage error for unknown flags", func() {
+			Expect(cmd.ExitCode(cmd.ExecuteArgs(root, []string{"version", "--does-not-exist"}))).To(Equal(cmd.ExitUsage))
+		})
+
+		It("returns a usage error for invalid arguments", func() {
+			Expect(cmd.ExitCode(cmd.ExecuteArgs(root, []string{"version", "extra"}))).To(Equal(cmd.ExitUsage))
+		})
+	})
+})
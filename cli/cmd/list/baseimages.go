@@ -54,19 +54,12 @@ func (l *ListBaseimagesCmd) ListBaseimages(client Client) error {
 		return fmt.Errorf("failed to list baseimages: %w", err)
 	}
 
-	switch l.Opts.OutputFormat {
-	case shared.OutputFormatJSON:
-		return io.PrintJSON(baseimages)
-	case shared.OutputFormatYAML:
-		return io.PrintYAML(baseimages)
-	}
-
-	t := io.GetTableWriter()
-	t.AppendHeader(table.Row{"ID", "Name", "SupportedUntil"})
-	for _, b := range baseimages {
-		t.AppendRow(table.Row{b.Id, b.Name, b.SupportedUntil.Format("2006-01-02")})
-	}
-	t.Render()
-
-	return nil
+	return io.PrintList(string(l.Opts.OutputFormat), baseimages, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"ID", "Name", "SupportedUntil"})
+		for _, b := range baseimages {
+			t.AppendRow(table.Row{b.Id, b.Name, b.SupportedUntil.Format("2006-01-02")})
+		}
+		return t
+	})
 }
@@ -12,4 +12,10 @@ type Client interface {
 	ListOrganizations() ([]api.Organization, error)
 	ListWorkspacePlans() ([]api.WorkspacePlan, error)
 	ListTeamMembers(teamId int) ([]api.TeamMember, error)
+	ListManagedServices(teamId int) ([]api.ManagedService, error)
+	ListManagedServiceProviders(teamId int) ([]api.ManagedServiceProvider, error)
+	ListSshKeys() ([]api.SshKey, error)
+	ListDomains(teamId int) ([]api.Domain, error)
+	ListEnvVars(workspaceId int) ([]api.EnvVar, error)
+	ListOrgMembers(orgId string) ([]api.OrgMember, error)
 }
@@ -0,0 +1,88 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ListDomainsCmd struct {
+	cmd           *cobra.Command
+	Opts          *ListOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddListDomainsCmd(p *cobra.Command, opts *ListOptions) {
+	l := ListDomainsCmd{
+		cmd: &cobra.Command{
+			Use:   "domains",
+			Short: "List custom domains",
+			Long:  `List custom domains of a team and the workspaces connected to them`,
+			Example: io.FormatExampleCommands("list domains", []io.Example{
+				{Cmd: "-t <team-id>", Desc: "List all custom domains of a team"},
+				{Cmd: "-t <team-id> -o json", Desc: "List all custom domains of a team in JSON format"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(p, l.cmd)
+}
+
+func (l *ListDomainsCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := l.ClientFactory(l.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	teamId, err := l.Opts.GetTeamId()
+	if err != nil {
+		return err
+	}
+
+	return l.ListDomains(client, teamId)
+}
+
+func (l *ListDomainsCmd) ListDomains(client Client, teamId int) error {
+	domains, err := client.ListDomains(teamId)
+	if err != nil {
+		return fmt.Errorf("failed to list domains: %w", err)
+	}
+
+	return io.PrintList(string(l.Opts.OutputFormat), domains, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Name", "Verified", "Certificate Issued", "Connections (Path: Workspace IDs)"})
+		for _, d := range domains {
+			t.AppendRow(table.Row{d.Name, d.DomainVerificationStatus.Verified, d.CertificateRequestStatus.Issued, formatConnections(d)})
+		}
+		return t
+	})
+}
+
+func formatConnections(d api.Domain) string {
+	paths := make([]string, 0, len(d.Workspaces))
+	for p := range d.Workspaces {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+
+	lines := make([]string, len(paths))
+	for i, p := range paths {
+		ids := make([]string, len(d.Workspaces[p]))
+		for j, id := range d.Workspaces[p] {
+			ids[j] = fmt.Sprintf("%d", id)
+		}
+		lines[i] = fmt.Sprintf("%s: %s", p, strings.Join(ids, ", "))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ListEnvCmd struct {
+	cmd           *cobra.Command
+	Opts          *ListOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddListEnvCmd(p *cobra.Command, opts *ListOptions) {
+	l := ListEnvCmd{
+		cmd: &cobra.Command{
+			Use:   "env",
+			Short: "List environment variables",
+			Long: io.Long(`List environment variables of a workspace.
+
+				Use the dotenv output format to export the variables to a .env file,
+				which can be imported into another workspace with 'create env --from-file'.`),
+			Example: io.FormatExampleCommands("list env", []io.Example{
+				{Cmd: "-w <workspace-id>", Desc: "List all environment variables of a workspace"},
+				{Cmd: "-w <workspace-id> -o dotenv > .env", Desc: "Export all environment variables of a workspace to a .env file"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(p, l.cmd)
+}
+
+func (l *ListEnvCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := l.ClientFactory(l.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	wsId, err := l.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	return l.ListEnv(client, wsId)
+}
+
+func (l *ListEnvCmd) ListEnv(client Client, wsId int) error {
+	vars, err := client.ListEnvVars(wsId)
+	if err != nil {
+		return fmt.Errorf("failed to list environment variables: %w", err)
+	}
+
+	switch l.Opts.OutputFormat {
+	case shared.OutputFormatDotEnv:
+		varMap := make(map[string]string, len(vars))
+		for _, v := range vars {
+			varMap[v.Name] = v.Value
+		}
+		fmt.Print(cs.FormatDotEnv(varMap))
+		return nil
+	}
+
+	return io.PrintList(string(l.Opts.OutputFormat), vars, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Name", "Value"})
+		for _, v := range vars {
+			t.AppendRow(table.Row{v.Name, v.Value})
+		}
+		return t
+	})
+}
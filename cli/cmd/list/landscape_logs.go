@@ -4,18 +4,18 @@
 package list
 
 import (
-	"bufio"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"log/slog"
-	"net/http"
-	"strings"
+	"os"
+	"os/signal"
+	"regexp"
 	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
-	"github.com/codesphere-cloud/cs-go/api/errors"
 	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	"github.com/codesphere-cloud/cs-go/pkg/cs"
 	csio "github.com/codesphere-cloud/cs-go/pkg/io"
@@ -26,6 +26,12 @@ type ListLandscapeLogsCmd struct {
 	cmd   *cobra.Command
 	scope LandscapeLogsScope
 	opts  *ListOptions
+	// API token of the API URL the logs are requested from
+	token   string
+	filter  logFilter
+	printer *logPrinter
+	// orders entries of multiple streams by timestamp, nil for a single stream
+	merger *logMerger
 }
 
 type LandscapeLogsScope struct {
@@ -34,17 +40,11 @@ type LandscapeLogsScope struct {
 	stage       *string
 	step        *int
 	replica     *string
-}
-
-type LogEntry struct {
-	Timestamp string `json:"timestamp"`
-	Kind      string `json:"kind"`
-	Data      string `json:"data"`
-}
-
-type SSE struct {
-	event string
-	data  string
+	follow      *bool
+	since       *string
+	until       *string
+	grep        *string
+	tail        *int
 }
 
 func AddListLandscapeLogsCmd(p *cobra.Command, opts *ListOptions) {
@@ -58,17 +58,35 @@ func AddListLandscapeLogsCmd(p *cobra.Command, opts *ListOptions) {
 				all replicas of that server.
 
 				If you provide a specific replica id, it will return the logs of
-				that replica only.`),
+				that replica only.
+
+				With --follow, the logs are streamed until interrupted with Ctrl+C.
+				Dropped connections are resumed where they left off.
+
+				Logs can be filtered by time with --since and --until, given as RFC3339 timestamp
+				or as duration relative to now, and by a regular expression with --grep.
+				With -o json, each log entry is printed as JSON object per line, including its
+				workspace, stage, step, server and replica.
+
+				Logs of all replicas are merged in timestamp order, prefixed by server and replica.
+				Prefixes are colored by server when printing to a terminal, unless NO_COLOR is set.
+				If the logs of a replica can't be retrieved, the logs of the other replicas are still printed.`),
 			Example: csio.FormatExampleCommands("list landscape-logs", []csio.Example{
 				{Cmd: "-w 637128 -s app", Desc: "Get logs from a server"},
 				{Cmd: "-w 637128", Desc: "Get all logs of all servers"},
 				{Cmd: "-w 637128 -r workspace-213d7a8c-48b4-42e2-8f70-c905ab04abb5-58d657cdc5-m8rrp", Desc: "Get logs from a replica"},
+				{Cmd: "-w 637128 -s app --follow", Desc: "Stream logs from a server until interrupted"},
+				{Cmd: "-w 637128 -s app --since 1h --grep 'error|warn'", Desc: "Get errors and warnings of the last hour"},
+				{Cmd: "-w 637128 -s app --tail 100 -f", Desc: "Get the last 100 log entries and stream new ones"},
+				{Cmd: "-w 637128 -o json | jq -r 'select(.kind == \"stderr\") | .data'", Desc: "Get logs as JSON lines"},
 			}),
 		},
 		opts: opts,
 	}
 	logCmd.cmd.RunE = logCmd.RunE
 	logCmd.parseLandscapeLogsFlags()
+	logCmd.cmd.MarkFlagsMutuallyExclusive("follow", "until")
+	_ = logCmd.cmd.RegisterFlagCompletionFunc("stage", cobra.FixedCompletions(shared.PipelineStages, cobra.ShellCompDirectiveNoFileComp))
 	shared.AddCmd(p, logCmd.cmd)
 }
 
@@ -78,14 +96,41 @@ func (logCmd *ListLandscapeLogsCmd) parseLandscapeLogsFlags() {
 		stage:   logCmd.cmd.Flags().String("stage", "run", "Stage to stream logs from"),
 		step:    logCmd.cmd.Flags().IntP("step", "n", 0, "Index of execution step (default 0)"),
 		replica: logCmd.cmd.Flags().StringP("replica", "r", "", "ID of server replica"),
+		follow:  logCmd.cmd.Flags().BoolP("follow", "f", false, "Keep streaming new logs, reconnecting if the connection drops, until interrupted"),
+		since:   logCmd.cmd.Flags().String("since", "", "Only logs after this time, RFC3339 or relative like 10m"),
+		until:   logCmd.cmd.Flags().String("until", "", "Only logs before this time, RFC3339 or relative like 10m"),
+		grep:    logCmd.cmd.Flags().String("grep", "", "Only logs matching this regular expression"),
+		tail:    logCmd.cmd.Flags().Int("tail", -1, "Only the last N logs of each replica, with --follow of the logs before the command started, -1 for all"),
 	}
 }
 
-func (l *ListLandscapeLogsCmd) RunE(_ *cobra.Command, args []string) (err error) {
+func (l *ListLandscapeLogsCmd) RunE(cmd *cobra.Command, args []string) (err error) {
 	l.scope.workspaceId, err = l.opts.GetWorkspaceId()
 	if err != nil {
 		return fmt.Errorf("failed to get workspace ID: %w", err)
 	}
+	l.token, err = l.opts.GetApiToken()
+	if err != nil {
+		return fmt.Errorf("failed to get API token: %w", err)
+	}
+
+	if err := l.parseFilterFlags(time.Now()); err != nil {
+		return err
+	}
+	switch l.opts.OutputFormat {
+	case "", shared.OutputFormatTable, shared.OutputFormatJSON:
+		l.printer = &logPrinter{
+			json:  l.opts.OutputFormat == shared.OutputFormatJSON,
+			color: useColor(log.Writer()),
+			out:   cmd.OutOrStdout(),
+		}
+	default:
+		return fmt.Errorf("output format %s not supported for landscape logs, use table or json", l.opts.OutputFormat)
+	}
+
+	// stop streaming cleanly on Ctrl+C
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	if *l.scope.replica != "" {
 		if *l.scope.server != "codesphere-ide" {
@@ -95,44 +140,57 @@ func (l *ListLandscapeLogsCmd) RunE(_ *cobra.Command, args []string) (err error)
 				"server", *l.scope.server,
 			)
 		}
-		return l.printLogsOfReplica("", *l.scope.step, *l.scope.replica)
+		return l.printLogsOfReplica(ctx, cs.LogSource{Replica: *l.scope.replica}, *l.scope.step)
 	}
 	if *l.scope.server != "" {
-		return l.printLogsOfServer()
+		return l.printLogsOfServer(ctx)
 	}
 	if *l.scope.stage != "run" {
-		return l.printLogsOfStage()
+		return l.printLogsOfStage(ctx)
 	}
-	return l.printAllLogs()
+	return l.printAllLogs(ctx)
 }
 
-func (l *ListLandscapeLogsCmd) printAllLogs() error {
+func (l *ListLandscapeLogsCmd) printAllLogs(ctx context.Context) error {
 	log.Println("Printing logs of all replicas")
 
-	replicas, err := cs.GetPipelineStatus(l.scope.workspaceId, *l.scope.stage)
+	replicas, err := cs.GetPipelineStatus(l.opts.GetApiUrl(), l.token, l.scope.workspaceId, *l.scope.stage)
 	if err != nil {
 		return fmt.Errorf("failed to get pipeline status: %w", err)
 	}
 
-	var wg sync.WaitGroup
+	l.merger = newLogMerger(logsMergeWindow, l.printer.print)
+	defer func() { l.merger = nil }()
+
+	var (
+		wg      sync.WaitGroup
+		failed  atomic.Int32
+		streams int
+	)
 	for _, replica := range replicas {
 		for step := range replica.Steps {
+			streams++
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				prefix := fmt.Sprintf("|%-10s|%s", replica.Server, lastN(replica.Replica, 11))
-				if err := l.printLogsOfReplica(prefix, step, replica.Replica); err != nil {
-					log.Printf("Error printling logs: %s\n", err.Error())
+				source := cs.LogSource{Server: replica.Server, Replica: replica.Replica}
+				if err := l.printLogsOfReplica(ctx, source, step); err != nil {
+					failed.Add(1)
+					log.Printf("Error printing logs of %s (step %d): %s\n", source.Replica, step, err.Error())
 				}
 			}()
 		}
 	}
 	wg.Wait()
+	l.merger.close()
 
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("failed to print logs of %d of %d replica steps", n, streams)
+	}
 	return nil
 }
 
-func (l *ListLandscapeLogsCmd) printLogsOfStage() error {
+func (l *ListLandscapeLogsCmd) printLogsOfStage(ctx context.Context) error {
 	endpoint := fmt.Sprintf(
 		"%s/workspaces/%d/logs/%s/%d",
 		l.opts.GetApiUrl(),
@@ -140,18 +198,20 @@ func (l *ListLandscapeLogsCmd) printLogsOfStage() error {
 		*l.scope.stage,
 		*l.scope.step,
 	)
-	return printLogsOfEndpoint("", endpoint)
+	return l.printLogsOfEndpoint(ctx, cs.LogSource{Stage: *l.scope.stage, Step: *l.scope.step}, endpoint)
 }
 
-func (l *ListLandscapeLogsCmd) printLogsOfReplica(prefix string, step int, replicaId string) error {
+func (l *ListLandscapeLogsCmd) printLogsOfReplica(ctx context.Context, source cs.LogSource, step int) error {
 	endpoint := fmt.Sprintf(
 		"%s/workspaces/%d/logs/run/%d/replica/%s",
 		l.opts.GetApiUrl(),
 		l.scope.workspaceId,
 		step,
-		replicaId,
+		source.Replica,
 	)
-	return printLogsOfEndpoint(prefix, endpoint)
+	source.Stage = "run"
+	source.Step = step
+	return l.printLogsOfEndpoint(ctx, source, endpoint)
 }
 
 // lastN returns the last n characters of s, or s itself if it has fewer than n.
@@ -162,7 +222,7 @@ func lastN(s string, n int) string {
 	return s[len(s)-n:]
 }
 
-func (l *ListLandscapeLogsCmd) printLogsOfServer() error {
+func (l *ListLandscapeLogsCmd) printLogsOfServer(ctx context.Context) error {
 	endpoint := fmt.Sprintf(
 		"%s/workspaces/%d/logs/run/%d/server/%s",
 		l.opts.GetApiUrl(),
@@ -170,94 +230,50 @@ func (l *ListLandscapeLogsCmd) printLogsOfServer() error {
 		*l.scope.step,
 		*l.scope.server,
 	)
-	return printLogsOfEndpoint("", endpoint)
+	return l.printLogsOfEndpoint(ctx, cs.LogSource{Stage: "run", Step: *l.scope.step, Server: *l.scope.server}, endpoint)
 }
 
-func printLogsOfEndpoint(prefix string, endpoint string) error {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+func (l *ListLandscapeLogsCmd) printLogsOfEndpoint(ctx context.Context, source cs.LogSource, endpoint string) error {
+	source.WorkspaceId = l.scope.workspaceId
+	print := func(e cs.LogEntry) { l.printer.print(source, e) }
+	if l.merger != nil {
+		print = func(e cs.LogEntry) { l.merger.add(source, e) }
+	}
 
-	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
-	if err != nil {
-		return fmt.Errorf("failed to construct request: %s", err)
+	var tail *logTail
+	if *l.scope.tail >= 0 {
+		tail = &logTail{n: *l.scope.tail, follow: *l.scope.follow, start: time.Now(), print: print}
+		print = tail.add
 	}
 
-	// Set the Accept header to indicate SSE
-	req.Header.Set("Accept", "text/event-stream")
-	err = cs.SetAuthoriziationHeader(req)
-	if err != nil {
-		return fmt.Errorf("failed to set header: %w", err)
+	err := cs.NewLogStream(endpoint, l.token, *l.scope.follow).Run(ctx, func(entries []cs.LogEntry) {
+		for _, e := range entries {
+			if l.filter.match(e) {
+				print(e)
+			}
+		}
+	})
+	if tail != nil {
+		tail.flush()
 	}
+	return err
+}
 
-	resp, err := http.DefaultClient.Do(req)
+func (l *ListLandscapeLogsCmd) parseFilterFlags(now time.Time) (err error) {
+	l.filter = logFilter{}
+	l.filter.since, err = parseLogTime(*l.scope.since, now)
 	if err != nil {
-		return fmt.Errorf("failed to request logs: %w", err)
+		return fmt.Errorf("invalid --since: %w", err)
 	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("log server responded with non-ok code: %d", resp.StatusCode)
+	l.filter.until, err = parseLogTime(*l.scope.until, now)
+	if err != nil {
+		return fmt.Errorf("invalid --until: %w", err)
 	}
-
-	reader := bufio.NewReader(resp.Body)
-
-	for {
-		sse := SSE{event: "", data: ""}
-		for { // SSE parsing
-			line, err := reader.ReadString('\n')
-			if err != nil {
-				if err == io.EOF {
-					return nil
-				}
-				return fmt.Errorf("failed to parse log: %s", err)
-			}
-
-			line = strings.TrimSpace(line)
-
-			if strings.HasPrefix(line, "data:") {
-				data := strings.TrimPrefix(line, "data:")
-				data = strings.TrimSpace(data)
-				if sse.data != "" {
-					sse.data += "\n" + data
-				} else {
-					sse.data = data
-				}
-			} else if strings.HasPrefix(line, "event:") {
-				event := strings.TrimPrefix(line, "event:")
-				event = strings.TrimSpace(event)
-				if sse.event != "" {
-					slog.Warn(
-						"Received multiple event types in same SSE.",
-						"old", sse.event,
-						"new", event,
-					)
-				}
-				sse.event = event
-			} else if strings.HasPrefix(line, "id:") {
-				// not implemented/used
-			} else if strings.HasPrefix(line, "retry:") {
-				slog.Warn("Received retry event, but not supported.")
-			} else if line == "" {
-				// empty line marks end of SSE
-				break
-			}
-		} // end SSE parsing
-		var logEntries []LogEntry
-		err := json.Unmarshal([]byte(sse.data), &logEntries)
+	if *l.scope.grep != "" {
+		l.filter.grep, err = regexp.Compile(*l.scope.grep)
 		if err != nil {
-			var errRes errors.APIErrorResponse
-			err = json.Unmarshal([]byte(sse.data), &errRes)
-			if err != nil {
-				return fmt.Errorf("error reading error json: %w", err)
-			}
-			return fmt.Errorf(
-				"API error %d %s: %s",
-				errRes.Status, errRes.Title, errRes.Detail,
-			)
-		}
-
-		for i := 0; i < len(logEntries); i++ {
-			log.Printf("%s%s| %s", logEntries[i].Timestamp, prefix, logEntries[i].Data)
+			return fmt.Errorf("invalid --grep: %w", err)
 		}
 	}
+	return nil
 }
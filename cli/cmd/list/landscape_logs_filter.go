@@ -0,0 +1,190 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+// logsTailIdle is how long the backlog is collected in follow mode before printing its tail,
+// if no entry logged after the start of the command arrives earlier.
+var logsTailIdle = time.Second
+
+// logRecord is a log entry with its source, printed as JSON line.
+type logRecord struct {
+	Timestamp   string `json:"timestamp"`
+	Kind        string `json:"kind"`
+	Data        string `json:"data"`
+	WorkspaceId int    `json:"workspaceId"`
+	Stage       string `json:"stage"`
+	Step        int    `json:"step"`
+	Server      string `json:"server,omitempty"`
+	Replica     string `json:"replica,omitempty"`
+}
+
+// logFilter selects log entries by time window and pattern.
+type logFilter struct {
+	// zero if unset
+	since time.Time
+	until time.Time
+	// nil if unset
+	grep *regexp.Regexp
+}
+
+// match returns true if the entry passes the filter. Entries without valid timestamp pass time windows.
+func (f *logFilter) match(e cs.LogEntry) bool {
+	if f.grep != nil && !f.grep.MatchString(e.Data) {
+		return false
+	}
+	if f.since.IsZero() && f.until.IsZero() {
+		return true
+	}
+	ts, err := cs.ParseLogTimestamp(e.Timestamp)
+	if err != nil {
+		return true
+	}
+	if !f.since.IsZero() && ts.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && ts.After(f.until) {
+		return false
+	}
+	return true
+}
+
+// parseLogTime parses a time given as RFC3339 timestamp or as duration relative to now, e.g. 10m for 10 minutes ago.
+func parseLogTime(value string, now time.Time) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d.Abs()), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s, must be a duration like 10m or a RFC3339 timestamp like 2006-01-02T15:04:05Z", value)
+	}
+	return t, nil
+}
+
+// logPrinter prints log entries as text via the log package or as JSON lines.
+type logPrinter struct {
+	json bool
+	// color text prefixes by server
+	color bool
+	out   io.Writer
+	mu    sync.Mutex
+}
+
+func (p *logPrinter) print(source cs.LogSource, e cs.LogEntry) {
+	if !p.json {
+		prefix := logPrefix(source)
+		if p.color && prefix != "" {
+			prefix = colorize(prefix, source.Server)
+		}
+		log.Printf("%s%s| %s", e.Timestamp, prefix, e.Data)
+		return
+	}
+
+	line, err := json.Marshal(logRecord{
+		Timestamp:   e.Timestamp,
+		Kind:        e.Kind,
+		Data:        e.Data,
+		WorkspaceId: source.WorkspaceId,
+		Stage:       source.Stage,
+		Step:        source.Step,
+		Server:      source.Server,
+		Replica:     source.Replica,
+	})
+	if err != nil {
+		log.Printf("Error marshalling log entry: %s\n", err)
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, _ = fmt.Fprintln(p.out, string(line))
+}
+
+// logPrefix returns the prefix of text output to tell replicas apart, empty for sources of a single replica or server.
+func logPrefix(source cs.LogSource) string {
+	if source.Server == "" || source.Replica == "" {
+		return ""
+	}
+	return fmt.Sprintf("|%-10s|%s", source.Server, lastN(source.Replica, 11))
+}
+
+// logTail prints only the last n entries of the backlog and all entries after it.
+//
+// Without follow mode, all entries of a stream are backlog and printed when the stream ends.
+// In follow mode, the backlog are entries logged before start. Its tail is printed with the first entry
+// logged after start, or when no entries arrived for a while.
+type logTail struct {
+	n      int
+	follow bool
+	start  time.Time
+	print  func(cs.LogEntry)
+
+	mu      sync.Mutex
+	backlog []cs.LogEntry
+	flushed bool
+	idle    *time.Timer
+}
+
+func (t *logTail) add(e cs.LogEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.flushed {
+		t.print(e)
+		return
+	}
+	if t.follow {
+		if ts, err := cs.ParseLogTimestamp(e.Timestamp); err == nil && !ts.Before(t.start) {
+			t.flushLocked()
+			t.print(e)
+			return
+		}
+	}
+
+	t.backlog = append(t.backlog, e)
+	if len(t.backlog) > t.n {
+		t.backlog = t.backlog[len(t.backlog)-t.n:]
+	}
+	if t.follow {
+		if t.idle == nil {
+			t.idle = time.AfterFunc(logsTailIdle, t.flush)
+		} else {
+			t.idle.Reset(logsTailIdle)
+		}
+	}
+}
+
+// flush prints the backlog, later entries are printed right away.
+func (t *logTail) flush() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.flushLocked()
+}
+
+func (t *logTail) flushLocked() {
+	if t.flushed {
+		return
+	}
+	t.flushed = true
+	if t.idle != nil {
+		t.idle.Stop()
+	}
+	for _, e := range t.backlog {
+		t.print(e)
+	}
+	t.backlog = nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"container/heap"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+// logsMergeWindow is how long entries of multiple streams are buffered to print them in timestamp order.
+var logsMergeWindow = 500 * time.Millisecond
+
+// serverColors are ANSI colors assigned to servers, avoiding black, white and colors hard to read on either background.
+var serverColors = []int{32, 33, 34, 35, 36, 91, 92, 93, 94, 95, 96}
+
+// logMerger prints entries of multiple log streams in timestamp order.
+//
+// Entries are buffered for the merge window after they arrived, entries arriving later than that
+// with an earlier timestamp are printed out of order.
+type logMerger struct {
+	window time.Duration
+	print  func(cs.LogSource, cs.LogEntry)
+	now    func() time.Time
+
+	mu      sync.Mutex
+	pending mergeHeap
+	seq     uint64
+
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+type mergeEntry struct {
+	source  cs.LogSource
+	entry   cs.LogEntry
+	ts      time.Time
+	arrived time.Time
+	// order of arrival, to keep the order of entries with equal timestamps
+	seq uint64
+}
+
+func newLogMerger(window time.Duration, print func(cs.LogSource, cs.LogEntry)) *logMerger {
+	m := &logMerger{
+		window:  window,
+		print:   print,
+		now:     time.Now,
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go m.run()
+	return m
+}
+
+// add buffers an entry of a stream.
+func (m *logMerger) add(source cs.LogSource, e cs.LogEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	arrived := m.now()
+	ts, err := cs.ParseLogTimestamp(e.Timestamp)
+	if err != nil {
+		// order entries without valid timestamp by arrival
+		ts = arrived
+	}
+	m.seq++
+	heap.Push(&m.pending, mergeEntry{source: source, entry: e, ts: ts, arrived: arrived, seq: m.seq})
+}
+
+// close prints all buffered entries and stops the merger.
+func (m *logMerger) close() {
+	close(m.stop)
+	<-m.stopped
+	m.flush(true)
+}
+
+func (m *logMerger) run() {
+	defer close(m.stopped)
+	ticker := time.NewTicker(max(m.window/5, 10*time.Millisecond))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+			m.flush(false)
+		}
+	}
+}
+
+// flush prints buffered entries in timestamp order, as long as the earliest one was buffered for the merge window.
+func (m *logMerger) flush(all bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	deadline := m.now().Add(-m.window)
+	for m.pending.Len() > 0 {
+		if !all && m.pending[0].arrived.After(deadline) {
+			return
+		}
+		next := heap.Pop(&m.pending).(mergeEntry)
+		m.print(next.source, next.entry)
+	}
+}
+
+type mergeHeap []mergeEntry
+
+func (h mergeHeap) Len() int { return len(h) }
+func (h mergeHeap) Less(i, j int) bool {
+	if !h[i].ts.Equal(h[j].ts) {
+		return h[i].ts.Before(h[j].ts)
+	}
+	return h[i].seq < h[j].seq
+}
+func (h mergeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x any)   { *h = append(*h, x.(mergeEntry)) }
+func (h *mergeHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// colorize wraps s in the ANSI color assigned to the server, the same server always gets the same color.
+func colorize(s string, server string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(server))
+	color := serverColors[h.Sum32()%uint32(len(serverColors))]
+	return fmt.Sprintf("\x1b[%dm%s\x1b[0m", color, s)
+}
+
+// useColor returns true if w is a terminal and colors aren't disabled by the NO_COLOR environment variable.
+func useColor(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	stat, err := f.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
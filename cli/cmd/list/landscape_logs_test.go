@@ -5,6 +5,7 @@ package list_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
+	"sync/atomic"
 
 	"github.com/spf13/cobra"
 
@@ -23,7 +26,7 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-func writeSSELogs(w http.ResponseWriter, entries []listcmd.LogEntry) {
+func writeSSELogs(w http.ResponseWriter, entries []cs.LogEntry) {
 	payload, _ := json.Marshal(entries)
 	_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", payload)
 }
@@ -37,9 +40,6 @@ var _ = Describe("ListLandscapeLogs", func() {
 		server     *httptest.Server
 		mux        *http.ServeMux
 		wsId       int
-
-		originalToken string
-		originalApi   string
 	)
 
 	BeforeEach(func() {
@@ -49,12 +49,8 @@ var _ = Describe("ListLandscapeLogs", func() {
 		mux = http.NewServeMux()
 		server = httptest.NewServer(mux)
 
-		// cs.GetPipelineStatus/cs.SetAuthoriziationHeader read CS_API/CS_TOKEN
-		// directly from the OS environment, independent of RootOptions.
-		originalApi = os.Getenv("CS_API")
-		originalToken = os.Getenv("CS_TOKEN")
-		_ = os.Setenv("CS_API", server.URL)
-		_ = os.Setenv("CS_TOKEN", "test-token")
+		// the token has to be looked up for the API URL given with --api
+		mockEnv.EXPECT().LookupApiToken(server.URL).Return("test-token", "", nil).Maybe()
 
 		globalOpts = &cmd.GlobalOptions{
 			Env:         mockEnv,
@@ -69,8 +65,6 @@ var _ = Describe("ListLandscapeLogs", func() {
 
 	AfterEach(func() {
 		server.Close()
-		_ = os.Setenv("CS_API", originalApi)
-		_ = os.Setenv("CS_TOKEN", originalToken)
 		mockEnv.AssertExpectations(GinkgoT())
 	})
 
@@ -104,7 +98,7 @@ var _ = Describe("ListLandscapeLogs", func() {
 		It("retrieves logs scoped to a server", func() {
 			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/server/app", wsId), func(w http.ResponseWriter, r *http.Request) {
 				Expect(r.Header.Get("Authorization")).To(Equal("Bearer test-token"))
-				writeSSELogs(w, []listcmd.LogEntry{{Timestamp: "t1", Kind: "stdout", Data: "server log line"}})
+				writeSSELogs(w, []cs.LogEntry{{Timestamp: "t1", Kind: "stdout", Data: "server log line"}})
 			})
 
 			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app"})
@@ -117,7 +111,7 @@ var _ = Describe("ListLandscapeLogs", func() {
 
 		It("retrieves logs scoped to a replica", func() {
 			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
-				writeSSELogs(w, []listcmd.LogEntry{{Timestamp: "t2", Kind: "stdout", Data: "replica log line"}})
+				writeSSELogs(w, []cs.LogEntry{{Timestamp: "t2", Kind: "stdout", Data: "replica log line"}})
 			})
 
 			parentCmd.SetArgs([]string{"landscape-logs", "-r", "replica-1"})
@@ -130,7 +124,7 @@ var _ = Describe("ListLandscapeLogs", func() {
 
 		It("retrieves logs scoped to a stage", func() {
 			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/build/2", wsId), func(w http.ResponseWriter, r *http.Request) {
-				writeSSELogs(w, []listcmd.LogEntry{{Timestamp: "t3", Kind: "stdout", Data: "stage log line"}})
+				writeSSELogs(w, []cs.LogEntry{{Timestamp: "t3", Kind: "stdout", Data: "stage log line"}})
 			})
 
 			parentCmd.SetArgs([]string{"landscape-logs", "--stage", "build", "-n", "2"})
@@ -150,7 +144,7 @@ var _ = Describe("ListLandscapeLogs", func() {
 				_ = json.NewEncoder(w).Encode(status)
 			})
 			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
-				writeSSELogs(w, []listcmd.LogEntry{{Timestamp: "t4", Kind: "stdout", Data: "all logs line"}})
+				writeSSELogs(w, []cs.LogEntry{{Timestamp: "t4", Kind: "stdout", Data: "all logs line"}})
 			})
 
 			parentCmd.SetArgs([]string{"landscape-logs"})
@@ -161,6 +155,170 @@ var _ = Describe("ListLandscapeLogs", func() {
 			Expect(output).To(ContainSubstring("all logs line"))
 		})
 	})
+
+	Context("filtering", func() {
+		BeforeEach(func() {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/server/app", wsId), func(w http.ResponseWriter, r *http.Request) {
+				writeSSELogs(w, []cs.LogEntry{
+					{Timestamp: "2026-01-01T10:00:00Z", Kind: "stdout", Data: "starting"},
+					{Timestamp: "2026-01-01T11:00:00Z", Kind: "stderr", Data: "error: failed"},
+					{Timestamp: "2026-01-01T12:00:00Z", Kind: "stdout", Data: "recovered"},
+					{Timestamp: "2026-01-01T13:00:00Z", Kind: "stderr", Data: "error: failed again"},
+				})
+			})
+		})
+
+		It("filters by time window and pattern", func() {
+			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app", "--since", "2026-01-01T10:30:00Z", "--until", "2026-01-01T12:30:00Z", "--grep", "^error"})
+			output := captureLogOutput(func() {
+				Expect(parentCmd.Execute()).To(Succeed())
+			})
+			Expect(output).To(ContainSubstring("error: failed"))
+			Expect(output).NotTo(ContainSubstring("starting"))
+			Expect(output).NotTo(ContainSubstring("recovered"))
+			Expect(output).NotTo(ContainSubstring("failed again"))
+		})
+
+		It("prints the last entries only", func() {
+			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app", "--tail", "2"})
+			output := captureLogOutput(func() {
+				Expect(parentCmd.Execute()).To(Succeed())
+			})
+			Expect(output).NotTo(ContainSubstring("starting"))
+			Expect(output).NotTo(ContainSubstring("error: failed\n"))
+			Expect(output).To(ContainSubstring("recovered"))
+			Expect(output).To(ContainSubstring("error: failed again"))
+		})
+
+		It("prints JSON lines with the source of each entry", func() {
+			listOpts.OutputFormat = "json"
+			var out bytes.Buffer
+			parentCmd.SetOut(&out)
+			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app", "--grep", "recovered"})
+
+			Expect(parentCmd.Execute()).To(Succeed())
+			Expect(out.String()).To(MatchJSON(`{
+				"timestamp": "2026-01-01T12:00:00Z",
+				"kind": "stdout",
+				"data": "recovered",
+				"workspaceId": 42,
+				"stage": "run",
+				"step": 0,
+				"server": "app"
+			}`))
+		})
+
+		It("rejects invalid times", func() {
+			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app", "--since", "yesterday"})
+			Expect(parentCmd.Execute()).To(MatchError(ContainSubstring("invalid --since")))
+		})
+	})
+
+	Context("merging replicas", func() {
+		BeforeEach(func() {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/pipeline/run", wsId), func(w http.ResponseWriter, r *http.Request) {
+				status := []cs.ReplicaStatus{
+					{State: "running", Steps: []cs.Step{{State: "done"}}, Replica: "replica-1", Server: "app"},
+					{State: "running", Steps: []cs.Step{{State: "done"}}, Replica: "replica-2", Server: "web"},
+				}
+				_ = json.NewEncoder(w).Encode(status)
+			})
+		})
+
+		It("prints entries of all replicas in timestamp order", func() {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
+				writeSSELogs(w, []cs.LogEntry{
+					{Timestamp: "2026-01-01T10:00:01Z", Kind: "stdout", Data: "first"},
+					{Timestamp: "2026-01-01T10:00:03Z", Kind: "stdout", Data: "third"},
+				})
+			})
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-2", wsId), func(w http.ResponseWriter, r *http.Request) {
+				writeSSELogs(w, []cs.LogEntry{
+					{Timestamp: "2026-01-01T10:00:02Z", Kind: "stdout", Data: "second"},
+					{Timestamp: "2026-01-01T10:00:04Z", Kind: "stdout", Data: "fourth"},
+				})
+			})
+
+			parentCmd.SetArgs([]string{"landscape-logs"})
+			output := captureLogOutput(func() {
+				err := parentCmd.Execute()
+				Expect(err).NotTo(HaveOccurred())
+			})
+			first := strings.Index(output, "first")
+			second := strings.Index(output, "second")
+			third := strings.Index(output, "third")
+			fourth := strings.Index(output, "fourth")
+			Expect(first).To(BeNumerically(">=", 0))
+			Expect(second).To(BeNumerically(">", first))
+			Expect(third).To(BeNumerically(">", second))
+			Expect(fourth).To(BeNumerically(">", third))
+			Expect(output).To(ContainSubstring("|app       |replica-1| first"))
+			Expect(output).NotTo(ContainSubstring("\x1b["))
+		})
+
+		It("prints the logs of other replicas if one fails", func() {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-2", wsId), func(w http.ResponseWriter, r *http.Request) {
+				writeSSELogs(w, []cs.LogEntry{{Timestamp: "2026-01-01T10:00:02Z", Kind: "stdout", Data: "still printed"}})
+			})
+
+			parentCmd.SetArgs([]string{"landscape-logs"})
+			var err error
+			output := captureLogOutput(func() {
+				err = parentCmd.Execute()
+			})
+			Expect(err).To(MatchError("failed to print logs of 1 of 2 replica steps"))
+			Expect(output).To(ContainSubstring("still printed"))
+			Expect(output).To(ContainSubstring("Error printing logs of replica-1 (step 0): log server responded with non-ok code: 404"))
+		})
+	})
+
+	Context("follow mode", func() {
+		It("reconnects with the last event ID and drops entries sent again", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			first := cs.LogEntry{Timestamp: "t1", Kind: "stdout", Data: "first line"}
+			second := cs.LogEntry{Timestamp: "t2", Kind: "stdout", Data: "second line"}
+			var connections atomic.Int32
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/server/app", wsId), func(w http.ResponseWriter, r *http.Request) {
+				switch connections.Add(1) {
+				case 1:
+					Expect(r.Header.Get("Last-Event-ID")).To(BeEmpty())
+					payload, _ := json.Marshal([]cs.LogEntry{first})
+					_, _ = fmt.Fprintf(w, "retry: 10\nid: 1\nevent: message\ndata: %s\n\n", payload)
+				case 2:
+					Expect(r.Header.Get("Last-Event-ID")).To(Equal("1"))
+					payload, _ := json.Marshal([]cs.LogEntry{first, second})
+					_, _ = fmt.Fprintf(w, "id: 2\nevent: message\ndata: %s\n\n", payload)
+				default:
+					Expect(r.Header.Get("Last-Event-ID")).To(Equal("2"))
+					cancel()
+				}
+			})
+
+			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app", "--follow"})
+			output := captureLogOutput(func() {
+				err := parentCmd.ExecuteContext(ctx)
+				Expect(err).NotTo(HaveOccurred())
+			})
+			Expect(connections.Load()).To(BeNumerically(">=", 3))
+			Expect(strings.Count(output, "first line")).To(Equal(1))
+			Expect(output).To(ContainSubstring("second line"))
+		})
+
+		It("fails on errors which aren't transient", func() {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/server/app", wsId), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+
+			parentCmd.SetArgs([]string{"landscape-logs", "-s", "app", "--follow"})
+			err := parentCmd.Execute()
+			Expect(err).To(MatchError(ContainSubstring("non-ok code: 404")))
+		})
+	})
 })
 
 var _ = Describe("AddListLandscapeLogsCmd", func() {
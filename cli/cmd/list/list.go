@@ -28,17 +28,28 @@ func AddListCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
 			Long:  `List resources available in Codesphere`,
 			Example: io.FormatExampleCommands("list", []io.Example{
 				{Cmd: "workspaces", Desc: "List all workspaces"},
+				{Cmd: "workspaces -o jsonpath='{.items[*].id}'", Desc: "List the IDs of all workspaces"},
+				{Cmd: "workspaces -o custom-columns=ID:.id,NAME:.name", Desc: "List workspaces with selected columns"},
+				{Cmd: "teams -o go-template='{{range .items}}{{.name}}{{\"\\n\"}}{{end}}'", Desc: "List team names with a Go template"},
 			}),
 		},
 	}
 
 	listOpts := &ListOptions{RootOptions: opts}
-	l.cmd.PersistentFlags().StringVarP((*string)(&listOpts.OutputFormat), "output", "o", "table", "Output format (table, json, yaml)")
-	l.cmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		if listOpts.OutputFormat != shared.OutputFormatTable && listOpts.OutputFormat != shared.OutputFormatJSON && listOpts.OutputFormat != shared.OutputFormatYAML {
+	l.cmd.PersistentFlags().StringVarP((*string)(&listOpts.OutputFormat), "output", "o", "table",
+		"Output format (table, json, yaml, csv, name, jsonpath=<template>, go-template=<template>, custom-columns=<HEADER:.field,...>, dotenv for 'list env')")
+	_ = l.cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(
+		[]cobra.Completion{"table", "json", "yaml", "csv", "name", "jsonpath=", "go-template=", "custom-columns=", "dotenv"},
+		cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace,
+	))
+	l.cmd.PersistentPreRunE = func(cmd *cobra.Command, _ []string) error {
+		if listOpts.OutputFormat == shared.OutputFormatDotEnv {
+			if cmd.Name() == "env" {
+				return nil
+			}
 			return fmt.Errorf("invalid output format: %s", listOpts.OutputFormat)
 		}
-		return nil
+		return io.ValidateListFormat(string(listOpts.OutputFormat))
 	}
 
 	shared.AddCmd(rootCmd, l.cmd)
@@ -49,4 +60,10 @@ func AddListCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
 	AddListPlansCmd(l.cmd, listOpts)
 	AddListTeamMembersCmd(l.cmd, listOpts)
 	AddListLandscapeLogsCmd(l.cmd, listOpts)
+	AddListServicesCmd(l.cmd, listOpts)
+	AddListServiceProvidersCmd(l.cmd, listOpts)
+	AddListSshKeysCmd(l.cmd, listOpts)
+	AddListDomainsCmd(l.cmd, listOpts)
+	AddListEnvCmd(l.cmd, listOpts)
+	AddListOrgMembersCmd(l.cmd, listOpts)
 }
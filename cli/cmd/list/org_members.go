@@ -0,0 +1,67 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ListOrgMembersCmd struct {
+	cmd           *cobra.Command
+	Opts          *ListOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddListOrgMembersCmd(p *cobra.Command, opts *ListOptions) {
+	l := ListOrgMembersCmd{
+		cmd: &cobra.Command{
+			Use:   "org-members",
+			Short: "List organization members",
+			Long:  `List all members of an organization`,
+			Example: io.FormatExampleCommands("list org-members", []io.Example{
+				{Cmd: "-O <org-id>", Desc: "List all members of an organization"},
+				{Cmd: "-O <org-id> -o json", Desc: "List all members of an organization in JSON format"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(p, l.cmd)
+}
+
+func (l *ListOrgMembersCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := l.ClientFactory(l.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	orgId, err := shared.RequireOrgId(l.Opts)
+	if err != nil {
+		return err
+	}
+
+	return l.ListOrgMembers(client, orgId)
+}
+
+func (l *ListOrgMembersCmd) ListOrgMembers(client Client, orgId string) error {
+	members, err := client.ListOrgMembers(orgId)
+	if err != nil {
+		return fmt.Errorf("failed to list organization members: %w", err)
+	}
+
+	return io.PrintList(string(l.Opts.OutputFormat), members, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"User ID", "Name", "Email", "Role", "Pending"})
+		for _, m := range members {
+			t.AppendRow(table.Row{m.UserId, m.GetName(), m.GetEmail(), m.Role, m.Pending})
+		}
+		return t
+	})
+}
@@ -0,0 +1,66 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list_test
+
+import (
+	"errors"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	listcmd "github.com/codesphere-cloud/cs-go/cli/cmd/list"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ListOrgMembers", func() {
+	var (
+		mockEnv    *cmd.MockEnv
+		mockClient *cmd.MockClient
+		l          *listcmd.ListOrgMembersCmd
+		orgId      string
+	)
+
+	BeforeEach(func() {
+		mockEnv = cmd.NewMockEnv(GinkgoT())
+		mockClient = cmd.NewMockClient(GinkgoT())
+		orgId = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		l = &listcmd.ListOrgMembersCmd{
+			Opts: &listcmd.ListOptions{
+				RootOptions: &cmd.GlobalOptions{
+					Env:   mockEnv,
+					OrgId: orgId,
+				},
+				OutputFormat: "json",
+			},
+			ClientFactory: func(opts shared.RootOptions) (listcmd.Client, error) {
+				return mockClient, nil
+			},
+		}
+	})
+
+	It("lists the members of the organization", func() {
+		name := "Jane"
+		mockClient.EXPECT().ListOrgMembers(orgId).Return([]api.OrgMember{{UserId: 12, Name: &name, Role: "admin"}}, nil).Once()
+
+		err := l.RunE(nil, []string{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns API errors", func() {
+		mockClient.EXPECT().ListOrgMembers(orgId).Return(nil, errors.New("forbidden")).Once()
+
+		err := l.RunE(nil, []string{})
+		Expect(err).To(MatchError("failed to list organization members: forbidden"))
+	})
+
+	It("requires an organization ID", func() {
+		mockEnv.EXPECT().GetOrgId().Return("")
+		l.Opts.RootOptions = &cmd.GlobalOptions{Env: mockEnv}
+
+		err := l.RunE(nil, []string{})
+		Expect(err).To(MatchError("organization ID not set, use the -O/--org flag or the CS_ORG_ID environment variable"))
+	})
+})
@@ -58,19 +58,12 @@ func (l *ListOrgCmd) ListOrganizations(client Client) ([]api.Organization, error
 		return nil, fmt.Errorf("failed to list organizations: %w", err)
 	}
 
-	switch l.Opts.OutputFormat {
-	case shared.OutputFormatJSON:
-		return orgs, io.PrintJSON(orgs)
-	case shared.OutputFormatYAML:
-		return orgs, io.PrintYAML(orgs)
-	}
-
-	t := io.GetTableWriter()
-	t.AppendHeader(table.Row{"ID", "Name"})
-	for _, org := range orgs {
-		t.AppendRow(table.Row{org.Id, org.Name})
-	}
-	t.Render()
-
-	return orgs, nil
+	return orgs, io.PrintList(string(l.Opts.OutputFormat), orgs, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"ID", "Name"})
+		for _, org := range orgs {
+			t.AppendRow(table.Row{org.Id, org.Name})
+		}
+		return t
+	})
 }
@@ -31,42 +31,35 @@ func (c *ListPlansCmd) RunE(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to list plans: %s", err)
 	}
 
-	switch c.Opts.OutputFormat {
-	case shared.OutputFormatJSON:
-		return io.PrintJSON(plans)
-	case shared.OutputFormatYAML:
-		return io.PrintYAML(plans)
-	}
-
-	t := io.GetTableWriter()
-	t.AppendHeader(table.Row{"ID", "Name", "On Demand", "CPU", "RAM(GiB)", "SSD(GiB)", "Price(USD)", "Max Replicas"})
-	t.SetColumnConfigs([]table.ColumnConfig{
-		{Name: "Price(USD)", Align: text.AlignRight},
-		{Name: "RAM(GiB)", Align: text.AlignRight},
-		{Name: "SSD(GiB)", Align: text.AlignRight},
-	})
-	for _, plan := range plans {
-		if plan.Deprecated {
-			continue
-		}
-		onDemand := ""
-		if plan.Characteristics.OnDemand {
-			onDemand = "*"
-		}
-		t.AppendRow(table.Row{
-			plan.Id,
-			plan.Title,
-			onDemand,
-			plan.Characteristics.CPU,
-			formatBytesAsGib(plan.Characteristics.RAM),
-			formatBytesAsGib(plan.Characteristics.SSD),
-			fmt.Sprintf("%.2f", plan.PriceUsd),
-			plan.MaxReplicas,
+	return io.PrintList(string(c.Opts.OutputFormat), plans, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"ID", "Name", "On Demand", "CPU", "RAM(GiB)", "SSD(GiB)", "Price(USD)", "Max Replicas"})
+		t.SetColumnConfigs([]table.ColumnConfig{
+			{Name: "Price(USD)", Align: text.AlignRight},
+			{Name: "RAM(GiB)", Align: text.AlignRight},
+			{Name: "SSD(GiB)", Align: text.AlignRight},
 		})
-	}
-	t.Render()
-
-	return nil
+		for _, plan := range plans {
+			if plan.Deprecated {
+				continue
+			}
+			onDemand := ""
+			if plan.Characteristics.OnDemand {
+				onDemand = "*"
+			}
+			t.AppendRow(table.Row{
+				plan.Id,
+				plan.Title,
+				onDemand,
+				plan.Characteristics.CPU,
+				formatBytesAsGib(plan.Characteristics.RAM),
+				formatBytesAsGib(plan.Characteristics.SSD),
+				fmt.Sprintf("%.2f", plan.PriceUsd),
+				plan.MaxReplicas,
+			})
+		}
+		return t
+	})
 }
 
 func formatBytesAsGib(in int) string {
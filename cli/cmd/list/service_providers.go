@@ -0,0 +1,79 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ListServiceProvidersCmd struct {
+	cmd           *cobra.Command
+	Opts          *ListOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddListServiceProvidersCmd(p *cobra.Command, opts *ListOptions) {
+	l := ListServiceProvidersCmd{
+		cmd: &cobra.Command{
+			Use:   "service-providers",
+			Short: "List managed service providers",
+			Long: io.Long(`List providers of managed services, e.g. Postgres or Redis, and their plans.
+
+				When creating a managed service you need to select a provider and one of its plans.`),
+			Example: io.FormatExampleCommands("list service-providers", []io.Example{
+				{Desc: "List all globally available providers"},
+				{Cmd: "-t <team-id>", Desc: "List all providers available to a team"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(p, l.cmd)
+}
+
+func (l *ListServiceProvidersCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := l.ClientFactory(l.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	teamId, err := l.Opts.GetTeamId()
+	if errors.Is(err, shared.ErrTeamIdNotSet) {
+		log.Println("No team ID provided via flag or environment variable, listing globally available providers")
+		teamId = -1
+	} else if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	return l.ListServiceProviders(client, teamId)
+}
+
+func (l *ListServiceProvidersCmd) ListServiceProviders(client Client, teamId int) error {
+	providers, err := client.ListManagedServiceProviders(teamId)
+	if err != nil {
+		return fmt.Errorf("failed to list managed service providers: %w", err)
+	}
+
+	return io.PrintList(string(l.Opts.OutputFormat), providers, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Name", "Version", "Display Name", "Category", "Plans (ID: Name)"})
+		for _, p := range providers {
+			plans := make([]string, len(p.Plans))
+			for i, plan := range p.Plans {
+				plans[i] = fmt.Sprintf("%d: %s", plan.Id, plan.Name)
+			}
+			t.AppendRow(table.Row{p.Name, p.Version, p.DisplayName, p.Category, strings.Join(plans, "\n")})
+		}
+		return t
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list_test
+
+import (
+	"errors"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	listcmd "github.com/codesphere-cloud/cs-go/cli/cmd/list"
+	"github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ListServiceProviders", func() {
+	var (
+		mockEnv    *cmd.MockEnv
+		mockClient *cmd.MockClient
+		opts       *cmd.GlobalOptions
+		l          listcmd.ListServiceProvidersCmd
+	)
+
+	BeforeEach(func() {
+		mockEnv = cmd.NewMockEnv(GinkgoT())
+		mockClient = cmd.NewMockClient(GinkgoT())
+		opts = &cmd.GlobalOptions{
+			Env:    mockEnv,
+			TeamId: -1,
+		}
+		l = listcmd.ListServiceProvidersCmd{
+			Opts: &listcmd.ListOptions{RootOptions: opts, OutputFormat: "json"},
+			ClientFactory: func(shared.RootOptions) (listcmd.Client, error) {
+				return mockClient, nil
+			},
+		}
+	})
+
+	It("lists the providers of the given team", func() {
+		opts.TeamId = 5
+		mockClient.EXPECT().ListManagedServiceProviders(5).Return([]api.ManagedServiceProvider{}, nil)
+
+		Expect(l.RunE(nil, nil)).To(Succeed())
+	})
+
+	It("lists globally available providers when no team is given", func() {
+		mockEnv.EXPECT().GetTeamId().Return(-1, nil)
+		mockClient.EXPECT().ListManagedServiceProviders(-1).Return([]api.ManagedServiceProvider{}, nil)
+
+		Expect(l.RunE(nil, nil)).To(Succeed())
+	})
+
+	It("returns the error if the team can't be resolved", func() {
+		mockEnv.EXPECT().GetTeamId().Return(-1, errors.New("invalid CS_TEAM_ID"))
+
+		err := l.RunE(nil, nil)
+		Expect(err).To(MatchError(ContainSubstring("failed to get team ID: invalid CS_TEAM_ID")))
+	})
+})
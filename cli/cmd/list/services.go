@@ -0,0 +1,77 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ListServicesCmd struct {
+	cmd           *cobra.Command
+	Opts          *ListOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddListServicesCmd(p *cobra.Command, opts *ListOptions) {
+	l := ListServicesCmd{
+		cmd: &cobra.Command{
+			Use:     "services",
+			Aliases: []string{"service"},
+			Short:   "List managed services",
+			Long:    `List managed services, e.g. databases, of a team`,
+			Example: io.FormatExampleCommands("list services", []io.Example{
+				{Cmd: "-t <team-id>", Desc: "List all managed services of a team"},
+				{Cmd: "-t <team-id> -o json", Desc: "List all managed services of a team in JSON format"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(p, l.cmd)
+}
+
+func (l *ListServicesCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := l.ClientFactory(l.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	teamId, err := l.Opts.GetTeamId()
+	if err != nil {
+		return err
+	}
+
+	return l.ListServices(client, teamId)
+}
+
+func (l *ListServicesCmd) ListServices(client Client, teamId int) error {
+	services, err := client.ListManagedServices(teamId)
+	if err != nil {
+		return fmt.Errorf("failed to list managed services: %w", err)
+	}
+
+	return io.PrintList(string(l.Opts.OutputFormat), services, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"ID", "Name", "Provider", "Version", "Plan", "State", "Paused"})
+		for _, s := range services {
+			t.AppendRow(table.Row{
+				s.Id,
+				s.Name,
+				s.Provider.Name,
+				s.Provider.Version,
+				s.Plan.Id,
+				api.ManagedServiceState(s),
+				s.Pause,
+			})
+		}
+		return t
+	})
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type ListSshKeysCmd struct {
+	cmd           *cobra.Command
+	Opts          *ListOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddListSshKeysCmd(p *cobra.Command, opts *ListOptions) {
+	l := ListSshKeysCmd{
+		cmd: &cobra.Command{
+			Use:   "ssh-keys",
+			Short: "List SSH public keys",
+			Long:  `List the SSH public keys of your Codesphere user account`,
+			Example: io.FormatExampleCommands("list ssh-keys", []io.Example{
+				{Desc: "List all SSH public keys"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(p, l.cmd)
+}
+
+func (l *ListSshKeysCmd) RunE(_ *cobra.Command, args []string) error {
+	client, err := l.ClientFactory(l.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return l.ListSshKeys(client)
+}
+
+func (l *ListSshKeysCmd) ListSshKeys(client Client) error {
+	keys, err := client.ListSshKeys()
+	if err != nil {
+		return fmt.Errorf("failed to list SSH keys: %w", err)
+	}
+
+	return io.PrintList(string(l.Opts.OutputFormat), keys, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Title", "Fingerprint", "Created"})
+		for _, k := range keys {
+			t.AppendRow(table.Row{k.Title, k.PublicKeyFingerprint, k.CreatedAt.Format("2006-01-02")})
+		}
+		return t
+	})
+}
@@ -57,27 +57,20 @@ func (l *ListTeamMembersCmd) ListTeamMembers(client Client, teamId int) error {
 		return fmt.Errorf("failed to list team members: %w", err)
 	}
 
-	switch l.Opts.OutputFormat {
-	case shared.OutputFormatJSON:
-		return io.PrintJSON(members)
-	case shared.OutputFormatYAML:
-		return io.PrintYAML(members)
-	}
-
-	t := io.GetTableWriter()
-	t.AppendHeader(table.Row{"User ID", "Name", "Email", "Role", "Pending"})
-	for _, m := range members {
-		name := ""
-		if m.Name != nil {
-			name = *m.Name
-		}
-		email := ""
-		if m.Email != nil {
-			email = *m.Email
+	return io.PrintList(string(l.Opts.OutputFormat), members, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"User ID", "Name", "Email", "Role", "Pending"})
+		for _, m := range members {
+			name := ""
+			if m.Name != nil {
+				name = *m.Name
+			}
+			email := ""
+			if m.Email != nil {
+				email = *m.Email
+			}
+			t.AppendRow(table.Row{m.UserId, name, email, cs.GetRoleName(m.Role), m.Pending})
 		}
-		t.AppendRow(table.Row{m.UserId, name, email, cs.GetRoleName(m.Role), m.Pending})
-	}
-	t.Render()
-
-	return nil
+		return t
+	})
 }
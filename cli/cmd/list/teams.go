@@ -49,27 +49,20 @@ func (l *ListTeamsCmd) RunE(_ *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return fmt.Errorf("failed to list teams: %w", err)
 	}
-	switch l.opts.OutputFormat {
-	case shared.OutputFormatJSON:
-		return io.PrintJSON(teams)
-	case shared.OutputFormatYAML:
-		return io.PrintYAML(teams)
-	}
-
-	t := io.GetTableWriter()
-	t.AppendHeader(table.Row{"P", "ID", "Name", "Role", "Default DC"})
-	for _, team := range teams {
-		first := ""
-		if team.IsFirst != nil && *team.IsFirst {
-			first = "*"
-		}
-		roleName := "N/A"
-		if team.Role != nil {
-			roleName = cs.GetRoleName(*team.Role)
+	return io.PrintList(string(l.opts.OutputFormat), teams, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"P", "ID", "Name", "Role", "Default DC"})
+		for _, team := range teams {
+			first := ""
+			if team.IsFirst != nil && *team.IsFirst {
+				first = "*"
+			}
+			roleName := "N/A"
+			if team.Role != nil {
+				roleName = cs.GetRoleName(*team.Role)
+			}
+			t.AppendRow(table.Row{first, team.Id, team.Name, roleName, team.DefaultDataCenterId})
 		}
-		t.AppendRow(table.Row{first, team.Id, team.Name, roleName, team.DefaultDataCenterId})
-	}
-	t.Render()
-
-	return nil
+		return t
+	})
 }
@@ -47,28 +47,22 @@ func (l *ListWorkspacesCmd) RunE(_ *cobra.Command, args []string) (err error) {
 		return fmt.Errorf("failed to list workspaces: %w", err)
 	}
 
-	switch l.Opts.OutputFormat {
-	case shared.OutputFormatJSON:
-		return io.PrintJSON(workspaces)
-	case shared.OutputFormatYAML:
-		return io.PrintYAML(workspaces)
-	}
-	t := io.GetTableWriter()
-	t.AppendHeader(table.Row{"Team ID", "ID", "Name", "Repository", "Dev Domain"})
-	for _, w := range workspaces {
-		gitUrl := ""
-		if w.GitUrl.IsSet() && w.GitUrl.Get() != nil {
-			gitUrl = *w.GitUrl.Get()
-		}
-		devDomain := ""
-		if w.DevDomain != nil {
-			devDomain = *w.DevDomain
+	return io.PrintList(string(l.Opts.OutputFormat), workspaces, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Team ID", "ID", "Name", "Repository", "Dev Domain"})
+		for _, w := range workspaces {
+			gitUrl := ""
+			if w.GitUrl.IsSet() && w.GitUrl.Get() != nil {
+				gitUrl = *w.GitUrl.Get()
+			}
+			devDomain := ""
+			if w.DevDomain != nil {
+				devDomain = *w.DevDomain
+			}
+			t.AppendRow(table.Row{w.TeamId, w.Id, w.Name, gitUrl, devDomain})
 		}
-		t.AppendRow(table.Row{w.TeamId, w.Id, w.Name, gitUrl, devDomain})
-	}
-	t.Render()
-
-	return nil
+		return t
+	})
 }
 
 func (l *ListWorkspacesCmd) ListWorkspaces(client Client) ([]api.Workspace, error) {
@@ -0,0 +1,201 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	csio "github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type LogsDumpCmd struct {
+	cmd  *cobra.Command
+	opts shared.RootOptions
+	Opts LogsDumpOpts
+}
+
+type LogsDumpOpts struct {
+	Out     string
+	Stages  []string
+	Follow  bool
+	MaxSize string
+	// number of rotated files kept per stream
+	MaxFiles int
+	Archive  bool
+}
+
+func AddLogsDumpCmd(p *cobra.Command, opts shared.RootOptions) {
+	d := LogsDumpCmd{
+		cmd: &cobra.Command{
+			Use:   "dump",
+			Short: "Save logs of all stages and replicas to disk",
+			Long: csio.Long(`Save the logs of all pipeline stages and of every replica and step to a directory,
+				one file per stream:
+
+				  <out>/prepare/step-<n>.log
+				  <out>/test/step-<n>.log
+				  <out>/run/<server>/<replica>/step-<n>.log
+
+				With --follow, the logs are streamed until interrupted with Ctrl+C. Files are rotated
+				when they exceed --max-size, keeping --max-files rotated files like step-0.log.1 per stream.
+
+				With --archive, the directory is also packed into a gzip compressed tarball <out>.tar.gz,
+				e.g. to attach it to a ticket.`),
+			Example: csio.FormatExampleCommands("logs dump", []csio.Example{
+				{Cmd: "-w 637128 --out logs/", Desc: "Save all logs of a workspace"},
+				{Cmd: "-w 637128 --out logs/ --stage run --follow --max-size 50MB", Desc: "Stream run logs to rotating files until interrupted"},
+				{Cmd: "-w 637128 --out incident-42/ --archive", Desc: "Save all logs and pack them into incident-42.tar.gz"},
+			}),
+			Args: cobra.NoArgs,
+		},
+		opts: opts,
+	}
+	d.cmd.Flags().StringVar(&d.Opts.Out, "out", "", "Directory to save the logs to")
+	d.cmd.Flags().StringSliceVar(&d.Opts.Stages, "stage", shared.PipelineStages, "Stages to save logs of")
+	d.cmd.Flags().BoolVarP(&d.Opts.Follow, "follow", "f", false, "Keep saving new logs, reconnecting if the connection drops, until interrupted")
+	d.cmd.Flags().StringVar(&d.Opts.MaxSize, "max-size", "100MB", "Size at which log files are rotated with --follow, e.g. 500KB, 100MB or 1GB")
+	d.cmd.Flags().IntVar(&d.Opts.MaxFiles, "max-files", 5, "Number of rotated files kept per stream")
+	d.cmd.Flags().BoolVar(&d.Opts.Archive, "archive", false, "Pack the logs into a gzip compressed tarball next to the output directory")
+	_ = d.cmd.MarkFlagRequired("out")
+	_ = d.cmd.MarkFlagDirname("out")
+	_ = d.cmd.RegisterFlagCompletionFunc("stage", cobra.FixedCompletions(shared.PipelineStages, cobra.ShellCompDirectiveNoFileComp))
+	shared.AddCmd(p, d.cmd)
+	d.cmd.RunE = d.RunE
+}
+
+func (d *LogsDumpCmd) RunE(cmd *cobra.Command, _ []string) error {
+	wsId, err := d.opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	// stop streaming cleanly on Ctrl+C
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return d.Dump(ctx, wsId)
+}
+
+// Dump saves the logs of the workspace and packs them into a tarball if requested.
+// Failing streams are reported after all other streams are saved.
+func (d *LogsDumpCmd) Dump(ctx context.Context, wsId int) error {
+	for _, s := range d.Opts.Stages {
+		if !slices.Contains(shared.PipelineStages, s) {
+			return fmt.Errorf("invalid stage %s, must be one of %s", s, strings.Join(shared.PipelineStages, ", "))
+		}
+	}
+	maxSize, err := parseSize(d.Opts.MaxSize)
+	if err != nil {
+		return fmt.Errorf("invalid --max-size: %w", err)
+	}
+	if !d.Opts.Follow {
+		// a dump without follow is a snapshot, rotating it would only lose logs
+		maxSize = 0
+	}
+
+	token, err := d.opts.GetApiToken()
+	if err != nil {
+		return fmt.Errorf("failed to get API token: %w", err)
+	}
+	targets, err := cs.CollectLogTargets(d.opts.GetApiUrl(), token, wsId, d.Opts.Stages)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no logs found for workspace %d", wsId)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Int32
+	)
+	for _, t := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			file, err := logFile(t.Source)
+			if err == nil {
+				err = d.dumpStream(ctx, t, token, file, maxSize)
+			}
+			if err != nil {
+				failed.Add(1)
+				log.Printf("Error saving logs of %s: %s\n", t.Source, err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+	log.Printf("Saved logs of %d streams to %s\n", len(targets)-int(failed.Load()), d.Opts.Out)
+
+	if d.Opts.Archive {
+		dir, err := filepath.Abs(d.Opts.Out)
+		if err != nil {
+			return fmt.Errorf("failed to get path of %s: %w", d.Opts.Out, err)
+		}
+		archive := dir + ".tar.gz"
+		if err := writeTarGz(archive, dir); err != nil {
+			return fmt.Errorf("failed to create archive: %w", err)
+		}
+		log.Printf("Created archive %s\n", archive)
+	}
+
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("failed to save logs of %d of %d streams", n, len(targets))
+	}
+	return nil
+}
+
+func (d *LogsDumpCmd) dumpStream(ctx context.Context, t cs.LogTarget, token string, file string, maxSize int64) error {
+	f, err := newRotatingFile(filepath.Join(d.Opts.Out, file), maxSize, d.Opts.MaxFiles)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	var writeErr error
+	err = cs.NewLogStream(t.Endpoint, token, d.Opts.Follow).Run(ctx, func(entries []cs.LogEntry) {
+		for _, e := range entries {
+			if writeErr != nil {
+				return
+			}
+			_, writeErr = fmt.Fprintf(f, "%s %s %s\n", e.Timestamp, e.Kind, e.Data)
+		}
+	})
+	if writeErr != nil {
+		return writeErr
+	}
+	return err
+}
+
+// logFile returns the file the logs of the source are saved to, relative to the dump directory.
+// Server and replica names are used as directories and must not point outside of it.
+func logFile(source cs.LogSource) (string, error) {
+	name := fmt.Sprintf("step-%d.log", source.Step)
+	if source.Server == "" && source.Replica == "" {
+		return filepath.Join(source.Stage, name), nil
+	}
+	for _, part := range []string{source.Server, source.Replica} {
+		if !isSafePathPart(part) {
+			return "", fmt.Errorf("invalid server or replica name %q", part)
+		}
+	}
+	return filepath.Join(source.Stage, source.Server, source.Replica, name), nil
+}
+
+// isSafePathPart returns true if name is a single path element other than . and ..
+func isSafePathPart(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	logscmd "github.com/codesphere-cloud/cs-go/cli/cmd/logs"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogsDump", func() {
+	var (
+		rootCmd *cobra.Command
+		server  *httptest.Server
+		mux     *http.ServeMux
+		wsId    int
+		out     string
+		// replicas returned by the pipeline status of the run stage
+		runStatus []cs.ReplicaStatus
+	)
+
+	BeforeEach(func() {
+		wsId = 42
+		out = filepath.Join(GinkgoT().TempDir(), "dump")
+
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		mockEnv := cmd.NewMockEnv(GinkgoT())
+		mockEnv.EXPECT().LookupApiToken(server.URL).Return("test-token", "", nil).Maybe()
+
+		runStatus = []cs.ReplicaStatus{{State: "running", Steps: []cs.Step{{State: "running"}}, Replica: "replica-1", Server: "app"}}
+		for stage, status := range map[string]*[]cs.ReplicaStatus{
+			"prepare": {{State: "success", Steps: []cs.Step{{State: "success"}, {State: "success"}}}},
+			"test":    {},
+			"run":     &runStatus,
+		} {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/pipeline/%s", wsId, stage), func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("Authorization")).To(Equal("Bearer test-token"))
+				_ = json.NewEncoder(w).Encode(*status)
+			})
+		}
+
+		rootCmd = &cobra.Command{Use: "cs"}
+		logscmd.AddLogsCmd(rootCmd, &cmd.GlobalOptions{Env: mockEnv, WorkspaceId: wsId, ApiUrl: server.URL})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	readFile := func(name string) string {
+		content, err := os.ReadFile(filepath.Join(out, name))
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	It("saves each stream to a file and packs them into a tarball", func() {
+		for _, step := range []int{0, 1} {
+			mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/prepare/%d", wsId, step), func(w http.ResponseWriter, r *http.Request) {
+				writeSSELogs(w, []cs.LogEntry{{Timestamp: "t1", Kind: "stdout", Data: fmt.Sprintf("prepare step %d", step)}})
+			})
+		}
+		mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
+			writeSSELogs(w, []cs.LogEntry{{Timestamp: "t2", Kind: "stderr", Data: "run line"}})
+		})
+
+		rootCmd.SetArgs([]string{"logs", "dump", "--out", out, "--archive"})
+		Expect(rootCmd.Execute()).To(Succeed())
+
+		Expect(readFile("prepare/step-0.log")).To(Equal("t1 stdout prepare step 0\n"))
+		Expect(readFile("prepare/step-1.log")).To(Equal("t1 stdout prepare step 1\n"))
+		Expect(readFile("run/app/replica-1/step-0.log")).To(Equal("t2 stderr run line\n"))
+
+		archive, err := os.Open(out + ".tar.gz")
+		Expect(err).NotTo(HaveOccurred())
+		defer func() { _ = archive.Close() }()
+		gz, err := gzip.NewReader(archive)
+		Expect(err).NotTo(HaveOccurred())
+		names := []string{}
+		tr := tar.NewReader(gz)
+		for {
+			header, err := tr.Next()
+			if err != nil {
+				break
+			}
+			names = append(names, header.Name)
+		}
+		Expect(names).To(ContainElements("dump/prepare/step-0.log", "dump/prepare/step-1.log", "dump/run/app/replica-1/step-0.log"))
+	})
+
+	It("saves the other streams if one fails", func() {
+		mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
+			writeSSELogs(w, []cs.LogEntry{{Timestamp: "t2", Kind: "stdout", Data: "run line"}})
+		})
+
+		rootCmd.SetArgs([]string{"logs", "dump", "--out", out, "--stage", "prepare,run"})
+		err := rootCmd.Execute()
+		Expect(err).To(MatchError("failed to save logs of 2 of 3 streams"))
+		Expect(readFile("run/app/replica-1/step-0.log")).To(Equal("t2 stdout run line\n"))
+	})
+
+	It("doesn't save logs of replicas with names pointing outside the output directory", func() {
+		runStatus = []cs.ReplicaStatus{
+			{Steps: []cs.Step{{State: "running"}}, Replica: "..", Server: "app"},
+			{Steps: []cs.Step{{State: "running"}}, Replica: "replica-1", Server: "../../escaped"},
+		}
+		rootCmd.SetArgs([]string{"logs", "dump", "--out", out, "--stage", "run"})
+		Expect(rootCmd.Execute()).To(MatchError("failed to save logs of 2 of 2 streams"))
+		_, err := os.Stat(filepath.Join(out, "..", "escaped"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+		_, err = os.Stat(filepath.Join(out, "run", "step-0.log"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+
+	It("rotates files exceeding the maximum size in follow mode", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var connections atomic.Int32
+		mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
+			n := connections.Add(1)
+			if n > 3 {
+				cancel()
+				return
+			}
+			payload, _ := json.Marshal([]cs.LogEntry{{Timestamp: "t", Kind: "stdout", Data: fmt.Sprintf("line %d", n)}})
+			_, _ = fmt.Fprintf(w, "retry: 10\nid: %d\ndata: %s\n\n", n, payload)
+		})
+
+		rootCmd.SetArgs([]string{"logs", "dump", "--out", out, "--stage", "run", "--follow", "--max-size", "20B", "--max-files", "1"})
+		Expect(rootCmd.ExecuteContext(ctx)).To(Succeed())
+
+		Expect(readFile("run/app/replica-1/step-0.log")).To(Equal("t stdout line 3\n"))
+		Expect(readFile("run/app/replica-1/step-0.log.1")).To(Equal("t stdout line 2\n"))
+		_, err := os.Stat(filepath.Join(out, "run/app/replica-1/step-0.log.2"))
+		Expect(err).To(MatchError(os.ErrNotExist))
+	})
+
+	It("rejects invalid sizes", func() {
+		rootCmd.SetArgs([]string{"logs", "dump", "--out", out, "--max-size", "lots"})
+		err := rootCmd.Execute()
+		Expect(err).To(MatchError(ContainSubstring("invalid --max-size: invalid size lots")))
+	})
+})
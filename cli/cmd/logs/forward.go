@@ -0,0 +1,310 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	csio "github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+// DefaultForwardRetryDelay is the delay before retrying to push a batch, doubled with each attempt.
+var DefaultForwardRetryDelay = time.Second
+
+// maxForwardRetryDelay caps the exponential backoff of retries.
+const maxForwardRetryDelay = 10 * time.Second
+
+type LogsForwardCmd struct {
+	cmd  *cobra.Command
+	opts shared.RootOptions
+	Opts LogsForwardOpts
+}
+
+type LogsForwardOpts struct {
+	Sink     string
+	Endpoint string
+	// key=value pairs sent as HTTP headers with each push, e.g. for authentication
+	Headers    []string
+	Stages     []string
+	Follow     bool
+	BatchSize  int
+	BatchWait  time.Duration
+	MaxRetries int
+}
+
+func AddLogsForwardCmd(p *cobra.Command, opts shared.RootOptions) {
+	f := LogsForwardCmd{
+		cmd: &cobra.Command{
+			Use:   "forward",
+			Short: "Forward logs to OpenTelemetry or Loki",
+			Long: csio.Long(`Forward the logs of all pipeline stages and of every replica and step to an
+				OpenTelemetry collector via OTLP/HTTP, or to the Loki push API.
+
+				OTLP log records carry the team, workspace, server and replica as resource attributes
+				(codesphere.team.id, codesphere.workspace.id, codesphere.server, codesphere.replica) and
+				the stage, step and stream as record attributes. Loki streams are labeled with
+				team_id, workspace_id, server, replica, stage, step and stream.
+
+				Entries are sent in batches of --batch-size entries, or after --batch-wait at the latest.
+				Failed pushes are retried with exponential backoff, batches failing --max-retries times are dropped.
+
+				By default, logs are streamed until interrupted with Ctrl+C. With --follow=false,
+				the logs logged so far are forwarded once.`),
+			Example: csio.FormatExampleCommands("logs forward", []csio.Example{
+				{Cmd: "-w 637128 --endpoint http://localhost:4318", Desc: "Forward all logs to a local OpenTelemetry collector"},
+				{Cmd: "-w 637128 --sink loki --endpoint http://loki:3100 --header X-Scope-OrgID=tenant", Desc: "Forward all logs to Loki"},
+				{Cmd: "-w 637128 --stage run --endpoint https://otlp.example.com --header \"Authorization=Bearer $TOKEN\"", Desc: "Forward run logs to an authenticated collector"},
+			}),
+			Args: cobra.NoArgs,
+		},
+		opts: opts,
+	}
+	f.cmd.Flags().StringVar(&f.Opts.Sink, "sink", SinkOTLP, "Where to forward the logs to, one of "+strings.Join(LogSinks, ", "))
+	f.cmd.Flags().StringVar(&f.Opts.Endpoint, "endpoint", "", "Base URL of the OTLP/HTTP collector or Loki, e.g. http://localhost:4318")
+	f.cmd.Flags().StringArrayVarP(&f.Opts.Headers, "header", "H", []string{}, "HTTP header sent to the endpoint as key=value (repeatable)")
+	f.cmd.Flags().StringSliceVar(&f.Opts.Stages, "stage", shared.PipelineStages, "Stages to forward logs of")
+	f.cmd.Flags().BoolVarP(&f.Opts.Follow, "follow", "f", true, "Keep forwarding new logs, reconnecting if the connection drops, until interrupted")
+	f.cmd.Flags().IntVar(&f.Opts.BatchSize, "batch-size", 500, "Maximum number of entries sent at once")
+	f.cmd.Flags().DurationVar(&f.Opts.BatchWait, "batch-wait", time.Second, "Maximum time entries are collected before sending them")
+	f.cmd.Flags().IntVar(&f.Opts.MaxRetries, "max-retries", 5, "Number of retries of failed pushes before dropping the batch")
+	_ = f.cmd.MarkFlagRequired("endpoint")
+	_ = f.cmd.RegisterFlagCompletionFunc("sink", cobra.FixedCompletions(LogSinks, cobra.ShellCompDirectiveNoFileComp))
+	_ = f.cmd.RegisterFlagCompletionFunc("stage", cobra.FixedCompletions(shared.PipelineStages, cobra.ShellCompDirectiveNoFileComp))
+	shared.AddCmd(p, f.cmd)
+	f.cmd.RunE = f.RunE
+}
+
+func (f *LogsForwardCmd) RunE(cmd *cobra.Command, _ []string) error {
+	wsId, err := f.opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	// stop streaming cleanly on Ctrl+C, entries not sent yet are dropped
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return f.Forward(ctx, wsId)
+}
+
+// Forward streams the logs of the workspace to the sink until the streams end or the context is done.
+// Failing streams and dropped batches are reported after all other streams are forwarded.
+func (f *LogsForwardCmd) Forward(ctx context.Context, wsId int) error {
+	for _, s := range f.Opts.Stages {
+		if !slices.Contains(shared.PipelineStages, s) {
+			return fmt.Errorf("invalid stage %s, must be one of %s", s, strings.Join(shared.PipelineStages, ", "))
+		}
+	}
+	if f.Opts.BatchSize <= 0 {
+		return fmt.Errorf("--batch-size must be positive")
+	}
+	headers := http.Header{}
+	for _, h := range f.Opts.Headers {
+		key, value, ok := strings.Cut(h, "=")
+		if !ok || key == "" {
+			return fmt.Errorf("invalid header %q, must be key=value", h)
+		}
+		headers.Add(key, value)
+	}
+	sink, err := newLogSink(f.Opts.Sink, f.Opts.Endpoint, headers)
+	if err != nil {
+		return err
+	}
+
+	teamId, err := workspaceTeamId(f.opts, wsId)
+	if err != nil {
+		return err
+	}
+	token, err := f.opts.GetApiToken()
+	if err != nil {
+		return fmt.Errorf("failed to get API token: %w", err)
+	}
+	targets, err := cs.CollectLogTargets(f.opts.GetApiUrl(), token, wsId, f.Opts.Stages)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		return fmt.Errorf("no logs found for workspace %d", wsId)
+	}
+
+	forwarder := newLogForwarder(ctx, sink, f.Opts.BatchSize, f.Opts.BatchWait, f.Opts.MaxRetries)
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Int32
+	)
+	for _, t := range targets {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := cs.NewLogStream(t.Endpoint, token, f.Opts.Follow).Run(ctx, func(entries []cs.LogEntry) {
+				for _, e := range entries {
+					forwarder.add(forwardEntry{source: t.Source, entry: e, teamId: teamId})
+				}
+			})
+			if err != nil {
+				failed.Add(1)
+				log.Printf("Error forwarding logs of %s: %s\n", t.Source, err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+	sent, dropped, interrupted := forwarder.close()
+	log.Printf("Forwarded %d log entries of %d streams to %s\n", sent, len(targets)-int(failed.Load()), f.Opts.Endpoint)
+	if interrupted > 0 {
+		log.Printf("Dropped %d log entries not sent before the interrupt\n", interrupted)
+	}
+
+	if dropped > 0 {
+		return fmt.Errorf("failed to forward %d log entries", dropped)
+	}
+	if n := failed.Load(); n > 0 {
+		return fmt.Errorf("failed to forward logs of %d of %d streams", n, len(targets))
+	}
+	return nil
+}
+
+// workspaceTeamId returns the team of the workspace, which is added to all forwarded entries.
+func workspaceTeamId(opts shared.RootOptions, wsId int) (int, error) {
+	client, err := opts.NewClient()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+	ws, err := client.GetWorkspace(wsId)
+	if errors.Is(err, cserrors.ErrNotFound) {
+		return 0, fmt.Errorf("workspace %d not found: %w", wsId, err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get team of workspace %d: %w", wsId, err)
+	}
+	return ws.TeamId, nil
+}
+
+// logForwarder sends entries to a sink in batches, retrying failed pushes.
+// Once the context is done, pending pushes are aborted and queued entries dropped.
+type logForwarder struct {
+	ctx        context.Context
+	sink       logSink
+	batchSize  int
+	batchWait  time.Duration
+	maxRetries int
+	retryDelay time.Duration
+
+	entries chan forwardEntry
+	done    chan struct{}
+	sent    int
+	// entries the sink failed to accept
+	dropped int
+	// entries not sent because the context is done
+	interrupted int
+}
+
+func newLogForwarder(ctx context.Context, sink logSink, batchSize int, batchWait time.Duration, maxRetries int) *logForwarder {
+	f := &logForwarder{
+		ctx:        ctx,
+		sink:       sink,
+		batchSize:  batchSize,
+		batchWait:  batchWait,
+		maxRetries: maxRetries,
+		retryDelay: DefaultForwardRetryDelay,
+		entries:    make(chan forwardEntry, batchSize),
+		done:       make(chan struct{}),
+	}
+	go f.run()
+	return f
+}
+
+// add queues an entry, blocking while the sink is too slow to keep up until the context is done.
+func (f *logForwarder) add(e forwardEntry) {
+	select {
+	case f.entries <- e:
+	case <-f.ctx.Done():
+	}
+}
+
+// close sends all queued entries and returns the number of entries sent, dropped by the sink and dropped due to the context.
+func (f *logForwarder) close() (sent int, dropped int, interrupted int) {
+	close(f.entries)
+	<-f.done
+	return f.sent, f.dropped, f.interrupted
+}
+
+func (f *logForwarder) run() {
+	defer close(f.done)
+	ticker := time.NewTicker(f.batchWait)
+	defer ticker.Stop()
+
+	batch := make([]forwardEntry, 0, f.batchSize)
+	for {
+		select {
+		case e, ok := <-f.entries:
+			if !ok {
+				f.flush(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) >= f.batchSize {
+				f.flush(batch)
+				batch = make([]forwardEntry, 0, f.batchSize)
+				ticker.Reset(f.batchWait)
+			}
+		case <-ticker.C:
+			f.flush(batch)
+			batch = make([]forwardEntry, 0, f.batchSize)
+		}
+	}
+}
+
+// flush sends the batch, retrying transient failures with exponential backoff.
+func (f *logForwarder) flush(batch []forwardEntry) {
+	if len(batch) == 0 {
+		return
+	}
+	delay := f.retryDelay
+	for attempt := 0; ; attempt++ {
+		if f.ctx.Err() != nil {
+			f.interrupted += len(batch)
+			return
+		}
+		err := f.sink.send(f.ctx, batch)
+		if err == nil {
+			f.sent += len(batch)
+			return
+		}
+		if f.ctx.Err() != nil {
+			f.interrupted += len(batch)
+			return
+		}
+		if !isTransient(err) || attempt >= f.maxRetries {
+			log.Printf("Error forwarding %d log entries, dropping them: %s\n", len(batch), err.Error())
+			f.dropped += len(batch)
+			return
+		}
+
+		slog.Warn("Forwarding logs failed, retrying.", "error", err, "delay", delay)
+		timer := time.NewTimer(delay)
+		select {
+		case <-f.ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+		delay = min(delay*2, maxForwardRetryDelay)
+	}
+}
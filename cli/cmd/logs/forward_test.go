@@ -0,0 +1,204 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	logscmd "github.com/codesphere-cloud/cs-go/cli/cmd/logs"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogsForward", func() {
+	var (
+		rootCmd   *cobra.Command
+		mockEnv   *cmd.MockEnv
+		server    *httptest.Server
+		collector *httptest.Server
+		mux       *http.ServeMux
+		wsId      int
+
+		// payloads received by the collector
+		mu       sync.Mutex
+		payloads []map[string]any
+		headers  []http.Header
+		respond  func(n int32) int
+		requests atomic.Int32
+
+		originalRetryDelay time.Duration
+	)
+
+	BeforeEach(func() {
+		wsId = 42
+		payloads = nil
+		headers = nil
+		requests.Store(0)
+		respond = func(int32) int { return http.StatusOK }
+
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+		mockEnv = cmd.NewMockEnv(GinkgoT())
+		mockEnv.EXPECT().LookupApiToken(server.URL).Return("test-token", "", nil).Maybe()
+		originalRetryDelay = logscmd.DefaultForwardRetryDelay
+		logscmd.DefaultForwardRetryDelay = time.Millisecond
+
+		mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d", wsId), func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprint(w, `{"id": 42, "teamId": 7, "name": "ws", "planId": 8, "isPrivateRepo": false, "replicas": 1,
+				"dataCenterId": 1, "userId": 1, "gitUrl": null, "initialBranch": null, "sourceWorkspaceId": null,
+				"welcomeMessage": null, "vpnConfig": null, "restricted": false, "collectTraces": false,
+				"persistentLogs": false, "createdAt": "2026-01-01T00:00:00Z"}`)
+		})
+		mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/pipeline/run", wsId), func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode([]cs.ReplicaStatus{
+				{State: "running", Steps: []cs.Step{{State: "running"}}, Replica: "replica-1", Server: "app"},
+			})
+		})
+		mux.HandleFunc(fmt.Sprintf("GET /workspaces/%d/logs/run/0/replica/replica-1", wsId), func(w http.ResponseWriter, r *http.Request) {
+			writeSSELogs(w, []cs.LogEntry{
+				{Timestamp: "2026-01-01T10:00:00Z", Kind: "stdout", Data: "hello"},
+				{Timestamp: "2026-01-01T10:00:01Z", Kind: "stderr", Data: "oops"},
+			})
+		})
+
+		// stand-in for an OpenTelemetry collector or Loki
+		collector = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := requests.Add(1)
+			status := respond(n)
+			if status == http.StatusOK {
+				body, _ := io.ReadAll(r.Body)
+				var payload map[string]any
+				Expect(json.Unmarshal(body, &payload)).To(Succeed())
+				mu.Lock()
+				payloads = append(payloads, payload)
+				headers = append(headers, r.Header.Clone())
+				mu.Unlock()
+			}
+			w.WriteHeader(status)
+		}))
+
+		rootCmd = &cobra.Command{Use: "cs"}
+		logscmd.AddLogsCmd(rootCmd, &cmd.GlobalOptions{Env: mockEnv, WorkspaceId: wsId, ApiUrl: server.URL})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		collector.Close()
+		logscmd.DefaultForwardRetryDelay = originalRetryDelay
+	})
+
+	forward := func(args ...string) error {
+		rootCmd.SetArgs(append([]string{"logs", "forward", "--stage", "run", "--follow=false", "--endpoint", collector.URL}, args...))
+		return rootCmd.Execute()
+	}
+
+	It("sends OTLP log records with resource attributes", func() {
+		Expect(forward("-H", "Authorization=Bearer collector-token")).To(Succeed())
+
+		Expect(payloads).To(HaveLen(1))
+		Expect(headers[0].Get("Authorization")).To(Equal("Bearer collector-token"))
+		resourceLogs := payloads[0]["resourceLogs"].([]any)
+		Expect(resourceLogs).To(HaveLen(1))
+		resource := resourceLogs[0].(map[string]any)
+		Expect(resource["resource"]).To(HaveKeyWithValue("attributes", ContainElements(
+			map[string]any{"key": "codesphere.team.id", "value": map[string]any{"intValue": "7"}},
+			map[string]any{"key": "codesphere.workspace.id", "value": map[string]any{"intValue": "42"}},
+			map[string]any{"key": "codesphere.server", "value": map[string]any{"stringValue": "app"}},
+			map[string]any{"key": "codesphere.replica", "value": map[string]any{"stringValue": "replica-1"}},
+		)))
+		records := resource["scopeLogs"].([]any)[0].(map[string]any)["logRecords"].([]any)
+		Expect(records).To(HaveLen(2))
+		first := records[0].(map[string]any)
+		Expect(first["body"]).To(Equal(map[string]any{"stringValue": "hello"}))
+		Expect(first["timeUnixNano"]).To(Equal(fmt.Sprint(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC).UnixNano())))
+		Expect(first["attributes"]).To(ContainElement(map[string]any{"key": "log.iostream", "value": map[string]any{"stringValue": "stdout"}}))
+	})
+
+	It("pushes Loki streams labeled by source", func() {
+		Expect(forward("--sink", "loki")).To(Succeed())
+
+		Expect(payloads).To(HaveLen(1))
+		streams := payloads[0]["streams"].([]any)
+		Expect(streams).To(HaveLen(2))
+		stdout := streams[0].(map[string]any)
+		Expect(stdout["stream"]).To(Equal(map[string]any{
+			"team_id": "7", "workspace_id": "42", "server": "app", "replica": "replica-1",
+			"stage": "run", "step": "0", "stream": "stdout",
+		}))
+		Expect(stdout["values"]).To(Equal([]any{[]any{fmt.Sprint(time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC).UnixNano()), "hello"}}))
+	})
+
+	It("sends entries in batches", func() {
+		Expect(forward("--batch-size", "1")).To(Succeed())
+		Expect(payloads).To(HaveLen(2))
+	})
+
+	It("retries transient failures", func() {
+		respond = func(n int32) int {
+			if n < 3 {
+				return http.StatusServiceUnavailable
+			}
+			return http.StatusOK
+		}
+		Expect(forward()).To(Succeed())
+		Expect(requests.Load()).To(Equal(int32(3)))
+		Expect(payloads).To(HaveLen(1))
+	})
+
+	It("drops batches rejected by the sink", func() {
+		respond = func(int32) int { return http.StatusBadRequest }
+		Expect(forward()).To(MatchError("failed to forward 2 log entries"))
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("drops batches after the maximum number of retries", func() {
+		respond = func(int32) int { return http.StatusTooManyRequests }
+		Expect(forward("--max-retries", "2")).To(MatchError("failed to forward 2 log entries"))
+		Expect(requests.Load()).To(Equal(int32(3)))
+	})
+
+	It("stops retrying when interrupted", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		logscmd.DefaultForwardRetryDelay = time.Hour
+		respond = func(int32) int {
+			cancel()
+			return http.StatusServiceUnavailable
+		}
+
+		rootCmd.SetArgs([]string{"logs", "forward", "--stage", "run", "--follow=false", "--endpoint", collector.URL})
+		start := time.Now()
+		Expect(rootCmd.ExecuteContext(ctx)).To(Succeed())
+		Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+
+	It("fails if the workspace doesn't exist", func() {
+		globalOpts := &cmd.GlobalOptions{Env: mockEnv, WorkspaceId: 43, ApiUrl: server.URL}
+		rootCmd = &cobra.Command{Use: "cs"}
+		logscmd.AddLogsCmd(rootCmd, globalOpts)
+		rootCmd.SetArgs([]string{"logs", "forward", "--endpoint", collector.URL})
+
+		err := rootCmd.Execute()
+		Expect(err).To(MatchError(ContainSubstring("workspace 43 not found")))
+		Expect(requests.Load()).To(BeZero())
+	})
+
+	It("rejects unknown sinks", func() {
+		Expect(forward("--sink", "syslog")).To(MatchError("invalid sink syslog, must be one of otlp, loki"))
+	})
+})
@@ -0,0 +1,26 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/spf13/cobra"
+)
+
+type LogsCmd struct {
+	cmd *cobra.Command
+}
+
+func AddLogsCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
+	l := LogsCmd{
+		cmd: &cobra.Command{
+			Use:   "logs",
+			Short: "Collect and forward workspace logs",
+			Long:  `Collect logs of all pipeline stages and replicas of a workspace, or forward them to an observability backend`,
+		},
+	}
+	shared.AddCmd(rootCmd, l.cmd)
+	AddLogsDumpCmd(l.cmd, opts)
+	AddLogsForwardCmd(l.cmd, opts)
+}
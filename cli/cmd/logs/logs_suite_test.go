@@ -0,0 +1,25 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestLogs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Logs Suite")
+}
+
+func writeSSELogs(w http.ResponseWriter, entries []cs.LogEntry) {
+	payload, _ := json.Marshal(entries)
+	_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", payload)
+}
@@ -0,0 +1,286 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+// Log sinks supported by logs forward.
+const (
+	SinkOTLP = "otlp"
+	SinkLoki = "loki"
+)
+
+var LogSinks = []string{SinkOTLP, SinkLoki}
+
+// sinkTimeout limits how long a single push to a sink may take.
+const sinkTimeout = 30 * time.Second
+
+// forwardEntry is a log entry with its source, as forwarded to a sink.
+type forwardEntry struct {
+	source cs.LogSource
+	entry  cs.LogEntry
+	// team of the workspace
+	teamId int
+}
+
+// logSink pushes batches of log entries to an observability backend.
+type logSink interface {
+	send(ctx context.Context, batch []forwardEntry) error
+}
+
+// newLogSink returns the sink of the given kind, endpoint is the base URL of the collector or Loki.
+func newLogSink(kind string, endpoint string, headers http.Header) (logSink, error) {
+	endpoint = strings.TrimRight(endpoint, "/")
+	client := &http.Client{Timeout: sinkTimeout}
+	switch kind {
+	case SinkOTLP:
+		if !strings.HasSuffix(endpoint, "/v1/logs") {
+			endpoint += "/v1/logs"
+		}
+		return &otlpSink{pusher: pusher{client: client, url: endpoint, headers: headers}}, nil
+	case SinkLoki:
+		if !strings.HasSuffix(endpoint, "/loki/api/v1/push") {
+			endpoint += "/loki/api/v1/push"
+		}
+		return &lokiSink{pusher: pusher{client: client, url: endpoint, headers: headers}}, nil
+	}
+	return nil, fmt.Errorf("invalid sink %s, must be one of %s", kind, strings.Join(LogSinks, ", "))
+}
+
+// pusher posts JSON payloads to a sink.
+type pusher struct {
+	client  *http.Client
+	url     string
+	headers http.Header
+}
+
+// push posts the payload. Network errors, 429 and 5xx responses are returned as transientError.
+func (p *pusher) push(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to construct request: %w", err)
+	}
+	for key, values := range p.headers {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return &transientError{fmt.Errorf("failed to push logs: %w", err)}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	err = fmt.Errorf("sink responded with non-ok code: %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return &transientError{err}
+	}
+	return err
+}
+
+// transientError is a failed push which may succeed when retried, e.g. a network error or an overloaded sink.
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+// isTransient returns true if retrying the push may succeed.
+func isTransient(err error) bool {
+	var t *transientError
+	return errors.As(err, &t)
+}
+
+// entryTime returns the timestamp of the entry, or now if it has no valid timestamp.
+func entryTime(e cs.LogEntry) time.Time {
+	if ts, err := cs.ParseLogTimestamp(e.Timestamp); err == nil {
+		return ts
+	}
+	return time.Now()
+}
+
+// otlpSink pushes log records to an OpenTelemetry collector with the OTLP/HTTP JSON encoding.
+// Team, workspace, server and replica are resource attributes, stage, step and stream are attributes of each record.
+type otlpSink struct {
+	pusher
+}
+
+type otlpPayload struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano         string          `json:"timeUnixNano"`
+	ObservedTimeUnixNano string          `json:"observedTimeUnixNano"`
+	Body                 otlpValue       `json:"body"`
+	Attributes           []otlpAttribute `json:"attributes"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+// otlpValue is an OTLP AnyValue, 64 bit integers are encoded as strings in JSON.
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+}
+
+func otlpString(key string, value string) otlpAttribute {
+	return otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func otlpInt(key string, value int) otlpAttribute {
+	v := strconv.Itoa(value)
+	return otlpAttribute{Key: key, Value: otlpValue{IntValue: &v}}
+}
+
+func (s *otlpSink) send(ctx context.Context, batch []forwardEntry) error {
+	return s.push(ctx, otlpLogs(batch, time.Now()))
+}
+
+// otlpLogs groups the entries by resource, keeping the order of the batch.
+func otlpLogs(batch []forwardEntry, observed time.Time) otlpPayload {
+	type resourceKey struct {
+		teamId  int
+		wsId    int
+		server  string
+		replica string
+	}
+	payload := otlpPayload{ResourceLogs: []otlpResourceLogs{}}
+	index := map[resourceKey]int{}
+	observedNano := strconv.FormatInt(observed.UnixNano(), 10)
+	for _, f := range batch {
+		key := resourceKey{f.teamId, f.source.WorkspaceId, f.source.Server, f.source.Replica}
+		i, ok := index[key]
+		if !ok {
+			attributes := []otlpAttribute{
+				otlpInt("codesphere.team.id", f.teamId),
+				otlpInt("codesphere.workspace.id", f.source.WorkspaceId),
+			}
+			if f.source.Server != "" {
+				attributes = append(attributes, otlpString("service.name", f.source.Server), otlpString("codesphere.server", f.source.Server))
+			}
+			if f.source.Replica != "" {
+				attributes = append(attributes, otlpString("codesphere.replica", f.source.Replica))
+			}
+			i = len(payload.ResourceLogs)
+			index[key] = i
+			payload.ResourceLogs = append(payload.ResourceLogs, otlpResourceLogs{
+				Resource:  otlpResource{Attributes: attributes},
+				ScopeLogs: []otlpScopeLogs{{Scope: otlpScope{Name: "cs logs forward"}, LogRecords: []otlpLogRecord{}}},
+			})
+		}
+
+		data := f.entry.Data
+		scope := &payload.ResourceLogs[i].ScopeLogs[0]
+		scope.LogRecords = append(scope.LogRecords, otlpLogRecord{
+			TimeUnixNano:         strconv.FormatInt(entryTime(f.entry).UnixNano(), 10),
+			ObservedTimeUnixNano: observedNano,
+			Body:                 otlpValue{StringValue: &data},
+			Attributes: []otlpAttribute{
+				otlpString("log.iostream", f.entry.Kind),
+				otlpString("codesphere.stage", f.source.Stage),
+				otlpInt("codesphere.step", f.source.Step),
+			},
+		})
+	}
+	return payload
+}
+
+// lokiSink pushes log lines to the Loki push API, labeled by team, workspace, stage, step, server, replica and stream.
+type lokiSink struct {
+	pusher
+}
+
+type lokiPayload struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	// pairs of timestamp in nanoseconds and log line
+	Values [][2]string `json:"values"`
+}
+
+func (s *lokiSink) send(ctx context.Context, batch []forwardEntry) error {
+	return s.push(ctx, lokiStreams(batch))
+}
+
+// lokiStreams groups the entries by labels, keeping the order of the batch.
+func lokiStreams(batch []forwardEntry) lokiPayload {
+	payload := lokiPayload{Streams: []lokiStream{}}
+	index := map[string]int{}
+	for _, f := range batch {
+		key := fmt.Sprintf("%d|%d|%s|%d|%s|%s|%s", f.teamId, f.source.WorkspaceId, f.source.Stage, f.source.Step, f.source.Server, f.source.Replica, f.entry.Kind)
+		i, ok := index[key]
+		if !ok {
+			labels := map[string]string{
+				"team_id":      strconv.Itoa(f.teamId),
+				"workspace_id": strconv.Itoa(f.source.WorkspaceId),
+				"stage":        f.source.Stage,
+				"step":         strconv.Itoa(f.source.Step),
+				"stream":       f.entry.Kind,
+			}
+			if f.source.Server != "" {
+				labels["server"] = f.source.Server
+			}
+			if f.source.Replica != "" {
+				labels["replica"] = f.source.Replica
+			}
+			i = len(payload.Streams)
+			index[key] = i
+			payload.Streams = append(payload.Streams, lokiStream{Stream: labels})
+		}
+		ts := strconv.FormatInt(entryTime(f.entry).UnixNano(), 10)
+		payload.Streams[i].Values = append(payload.Streams[i].Values, [2]string{ts, f.entry.Data})
+	}
+	return payload
+}
@@ -0,0 +1,27 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+)
+
+type MigrateCmd struct {
+	cmd *cobra.Command
+}
+
+func AddMigrateCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	migrate := MigrateCmd{
+		cmd: &cobra.Command{
+			Use:   "migrate",
+			Short: "Migrate Codesphere resources",
+			Long:  `Migrate Codesphere resources, e.g. move standalone teams into an organization.`,
+		},
+	}
+	shared.AddCmd(rootCmd, migrate.cmd)
+
+	AddMigrateTeamCmd(migrate.cmd, opts)
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type MigrateTeamCmd struct {
+	cmd  *cobra.Command
+	Opts MigrateTeamOpts
+}
+
+type MigrateTeamOpts struct {
+	*GlobalOptions
+	Force bool
+}
+
+func (c *MigrateTeamCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	orgId, err := shared.RequireOrgId(c.Opts.GlobalOptions)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.MigrateTeam(client, teamId, orgId)
+}
+
+func AddMigrateTeamCmd(migrate *cobra.Command, opts *GlobalOptions) {
+	team := MigrateTeamCmd{
+		cmd: &cobra.Command{
+			Use:   "team",
+			Short: "Move a team into an organization",
+			Long: io.Long(`Move a standalone team into an organization.
+
+				The target organization is selected with the -O/--org flag or the CS_ORG_ID environment variable.
+				The migration fails if team members don't belong to the organization, unless --force is set.
+				With --force, team members not belonging to the organization are removed from the team.`),
+			Example: io.FormatExampleCommands("migrate team", []io.Example{
+				{Cmd: "-t <team-id> --org <org-id>", Desc: "Move a team into an organization"},
+				{Cmd: "-t <team-id> --org <org-id> --force", Desc: "Move a team into an organization, removing members outside of the organization"},
+			}),
+		},
+		Opts: MigrateTeamOpts{GlobalOptions: opts},
+	}
+	team.cmd.Flags().BoolVar(&team.Opts.Force, "force", false, "Remove team members not belonging to the organization")
+	team.cmd.RunE = team.RunE
+	shared.AddCmd(migrate, team.cmd)
+}
+
+func (c *MigrateTeamCmd) MigrateTeam(client Client, teamId int, orgId string) error {
+	err := client.MigrateTeamToOrg(teamId, orgId, c.Opts.Force)
+	if err != nil {
+		return fmt.Errorf("failed to migrate team %d to organization %s: %w", teamId, orgId, err)
+	}
+
+	log.Printf("Team %d migrated to organization %s\n", teamId, orgId)
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("MigrateTeam", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.MigrateTeamCmd
+		orgId      string
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		orgId = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		c = &cmd.MigrateTeamCmd{
+			Opts: cmd.MigrateTeamOpts{GlobalOptions: &cmd.GlobalOptions{}},
+		}
+	})
+
+	It("migrates the team with force", func() {
+		c.Opts.Force = true
+		mockClient.EXPECT().MigrateTeamToOrg(5, orgId, true).Return(nil)
+
+		err := c.MigrateTeam(mockClient, 5, orgId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns API errors", func() {
+		mockClient.EXPECT().MigrateTeamToOrg(5, orgId, false).Return(errors.New("membership mismatch"))
+
+		err := c.MigrateTeam(mockClient, 5, orgId)
+		Expect(err).To(MatchError("failed to migrate team 5 to organization " + orgId + ": membership mismatch"))
+	})
+})
@@ -9,9 +9,88 @@ import (
 	"github.com/codesphere-cloud/cs-go/api"
 	mock "github.com/stretchr/testify/mock"
 	"io"
+	"net/url"
 	"time"
 )
 
+// NewMockPrompt creates a new instance of MockPrompt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPrompt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPrompt {
+	mock := &MockPrompt{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPrompt is an autogenerated mock type for the Prompt type
+type MockPrompt struct {
+	mock.Mock
+}
+
+type MockPrompt_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPrompt) EXPECT() *MockPrompt_Expecter {
+	return &MockPrompt_Expecter{mock: &_m.Mock}
+}
+
+// InputPrompt provides a mock function for the type MockPrompt
+func (_mock *MockPrompt) InputPrompt(prompt string) string {
+	ret := _mock.Called(prompt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InputPrompt")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(prompt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockPrompt_InputPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InputPrompt'
+type MockPrompt_InputPrompt_Call struct {
+	*mock.Call
+}
+
+// InputPrompt is a helper method to define mock.On call
+//   - prompt string
+func (_e *MockPrompt_Expecter) InputPrompt(prompt any) *MockPrompt_InputPrompt_Call {
+	return &MockPrompt_InputPrompt_Call{Call: _e.mock.On("InputPrompt", prompt)}
+}
+
+func (_c *MockPrompt_InputPrompt_Call) Run(run func(prompt string)) *MockPrompt_InputPrompt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrompt_InputPrompt_Call) Return(s string) *MockPrompt_InputPrompt_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockPrompt_InputPrompt_Call) RunAndReturn(run func(prompt string) string) *MockPrompt_InputPrompt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockClient(t interface {
@@ -39,49 +118,49 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
-// AddTeamMember provides a mock function for the type MockClient
-func (_mock *MockClient) AddTeamMember(teamId int, email string, role int) error {
-	ret := _mock.Called(teamId, email, role)
+// AddOrgMember provides a mock function for the type MockClient
+func (_mock *MockClient) AddOrgMember(orgId string, email string, role string) error {
+	ret := _mock.Called(orgId, email, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AddTeamMember")
+		panic("no return value specified for AddOrgMember")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, int) error); ok {
-		r0 = returnFunc(teamId, email, role)
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = returnFunc(orgId, email, role)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClient_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
-type MockClient_AddTeamMember_Call struct {
+// MockClient_AddOrgMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddOrgMember'
+type MockClient_AddOrgMember_Call struct {
 	*mock.Call
 }
 
-// AddTeamMember is a helper method to define mock.On call
-//   - teamId int
+// AddOrgMember is a helper method to define mock.On call
+//   - orgId string
 //   - email string
-//   - role int
-func (_e *MockClient_Expecter) AddTeamMember(teamId any, email any, role any) *MockClient_AddTeamMember_Call {
-	return &MockClient_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember", teamId, email, role)}
+//   - role string
+func (_e *MockClient_Expecter) AddOrgMember(orgId any, email any, role any) *MockClient_AddOrgMember_Call {
+	return &MockClient_AddOrgMember_Call{Call: _e.mock.On("AddOrgMember", orgId, email, role)}
 }
 
-func (_c *MockClient_AddTeamMember_Call) Run(run func(teamId int, email string, role int)) *MockClient_AddTeamMember_Call {
+func (_c *MockClient_AddOrgMember_Call) Run(run func(orgId string, email string, role string)) *MockClient_AddOrgMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].(string)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 int
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(int)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -92,130 +171,102 @@ func (_c *MockClient_AddTeamMember_Call) Run(run func(teamId int, email string,
 	return _c
 }
 
-func (_c *MockClient_AddTeamMember_Call) Return(err error) *MockClient_AddTeamMember_Call {
+func (_c *MockClient_AddOrgMember_Call) Return(err error) *MockClient_AddOrgMember_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_AddTeamMember_Call) RunAndReturn(run func(teamId int, email string, role int) error) *MockClient_AddTeamMember_Call {
+func (_c *MockClient_AddOrgMember_Call) RunAndReturn(run func(orgId string, email string, role string) error) *MockClient_AddOrgMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateOrganization provides a mock function for the type MockClient
-func (_mock *MockClient) CreateOrganization(name string, adminEmail string) (*api.Organization, error) {
-	ret := _mock.Called(name, adminEmail)
+// AddSshKey provides a mock function for the type MockClient
+func (_mock *MockClient) AddSshKey(publicKey string) error {
+	ret := _mock.Called(publicKey)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateOrganization")
+		panic("no return value specified for AddSshKey")
 	}
 
-	var r0 *api.Organization
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (*api.Organization, error)); ok {
-		return returnFunc(name, adminEmail)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) *api.Organization); ok {
-		r0 = returnFunc(name, adminEmail)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.Organization)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(name, adminEmail)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(publicKey)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
-type MockClient_CreateOrganization_Call struct {
+// MockClient_AddSshKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSshKey'
+type MockClient_AddSshKey_Call struct {
 	*mock.Call
 }
 
-// CreateOrganization is a helper method to define mock.On call
-//   - name string
-//   - adminEmail string
-func (_e *MockClient_Expecter) CreateOrganization(name any, adminEmail any) *MockClient_CreateOrganization_Call {
-	return &MockClient_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", name, adminEmail)}
+// AddSshKey is a helper method to define mock.On call
+//   - publicKey string
+func (_e *MockClient_Expecter) AddSshKey(publicKey any) *MockClient_AddSshKey_Call {
+	return &MockClient_AddSshKey_Call{Call: _e.mock.On("AddSshKey", publicKey)}
 }
 
-func (_c *MockClient_CreateOrganization_Call) Run(run func(name string, adminEmail string)) *MockClient_CreateOrganization_Call {
+func (_c *MockClient_AddSshKey_Call) Run(run func(publicKey string)) *MockClient_AddSshKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
-		var arg1 string
-		if args[1] != nil {
-			arg1 = args[1].(string)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_CreateOrganization_Call) Return(v *api.Organization, err error) *MockClient_CreateOrganization_Call {
-	_c.Call.Return(v, err)
+func (_c *MockClient_AddSshKey_Call) Return(err error) *MockClient_AddSshKey_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_CreateOrganization_Call) RunAndReturn(run func(name string, adminEmail string) (*api.Organization, error)) *MockClient_CreateOrganization_Call {
+func (_c *MockClient_AddSshKey_Call) RunAndReturn(run func(publicKey string) error) *MockClient_AddSshKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTeam provides a mock function for the type MockClient
-func (_mock *MockClient) CreateTeam(orgId string, name string, dcId int) (*api.Team, error) {
-	ret := _mock.Called(orgId, name, dcId)
+// AddTeamMember provides a mock function for the type MockClient
+func (_mock *MockClient) AddTeamMember(teamId int, email string, role int) error {
+	ret := _mock.Called(teamId, email, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTeam")
+		panic("no return value specified for AddTeamMember")
 	}
 
-	var r0 *api.Team
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string, int) (*api.Team, error)); ok {
-		return returnFunc(orgId, name, dcId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string, string, int) *api.Team); ok {
-		r0 = returnFunc(orgId, name, dcId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.Team)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string, string, int) error); ok {
-		r1 = returnFunc(orgId, name, dcId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, int) error); ok {
+		r0 = returnFunc(teamId, email, role)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
-type MockClient_CreateTeam_Call struct {
+// MockClient_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
+type MockClient_AddTeamMember_Call struct {
 	*mock.Call
 }
 
-// CreateTeam is a helper method to define mock.On call
-//   - orgId string
-//   - name string
-//   - dcId int
-func (_e *MockClient_Expecter) CreateTeam(orgId any, name any, dcId any) *MockClient_CreateTeam_Call {
-	return &MockClient_CreateTeam_Call{Call: _e.mock.On("CreateTeam", orgId, name, dcId)}
+// AddTeamMember is a helper method to define mock.On call
+//   - teamId int
+//   - email string
+//   - role int
+func (_e *MockClient_Expecter) AddTeamMember(teamId any, email any, role any) *MockClient_AddTeamMember_Call {
+	return &MockClient_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember", teamId, email, role)}
 }
 
-func (_c *MockClient_CreateTeam_Call) Run(run func(orgId string, name string, dcId int)) *MockClient_CreateTeam_Call {
+func (_c *MockClient_AddTeamMember_Call) Run(run func(teamId int, email string, role int)) *MockClient_AddTeamMember_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int)
 		}
 		var arg1 string
 		if args[1] != nil {
@@ -234,148 +285,183 @@ func (_c *MockClient_CreateTeam_Call) Run(run func(orgId string, name string, dc
 	return _c
 }
 
-func (_c *MockClient_CreateTeam_Call) Return(team *api.Team, err error) *MockClient_CreateTeam_Call {
-	_c.Call.Return(team, err)
+func (_c *MockClient_AddTeamMember_Call) Return(err error) *MockClient_AddTeamMember_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_CreateTeam_Call) RunAndReturn(run func(orgId string, name string, dcId int) (*api.Team, error)) *MockClient_CreateTeam_Call {
+func (_c *MockClient_AddTeamMember_Call) RunAndReturn(run func(teamId int, email string, role int) error) *MockClient_AddTeamMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTeam provides a mock function for the type MockClient
-func (_mock *MockClient) DeleteTeam(teamId int) error {
-	ret := _mock.Called(teamId)
+// ChangeOrgMemberRole provides a mock function for the type MockClient
+func (_mock *MockClient) ChangeOrgMemberRole(orgId string, userId int, role string) error {
+	ret := _mock.Called(orgId, userId, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTeam")
+		panic("no return value specified for ChangeOrgMemberRole")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
-		r0 = returnFunc(teamId)
+	if returnFunc, ok := ret.Get(0).(func(string, int, string) error); ok {
+		r0 = returnFunc(orgId, userId, role)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClient_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
-type MockClient_DeleteTeam_Call struct {
+// MockClient_ChangeOrgMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeOrgMemberRole'
+type MockClient_ChangeOrgMemberRole_Call struct {
 	*mock.Call
 }
 
-// DeleteTeam is a helper method to define mock.On call
-//   - teamId int
-func (_e *MockClient_Expecter) DeleteTeam(teamId any) *MockClient_DeleteTeam_Call {
-	return &MockClient_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", teamId)}
+// ChangeOrgMemberRole is a helper method to define mock.On call
+//   - orgId string
+//   - userId int
+//   - role string
+func (_e *MockClient_Expecter) ChangeOrgMemberRole(orgId any, userId any, role any) *MockClient_ChangeOrgMemberRole_Call {
+	return &MockClient_ChangeOrgMemberRole_Call{Call: _e.mock.On("ChangeOrgMemberRole", orgId, userId, role)}
 }
 
-func (_c *MockClient_DeleteTeam_Call) Run(run func(teamId int)) *MockClient_DeleteTeam_Call {
+func (_c *MockClient_ChangeOrgMemberRole_Call) Run(run func(orgId string, userId int, role string)) *MockClient_ChangeOrgMemberRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_DeleteTeam_Call) Return(err error) *MockClient_DeleteTeam_Call {
+func (_c *MockClient_ChangeOrgMemberRole_Call) Return(err error) *MockClient_ChangeOrgMemberRole_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_DeleteTeam_Call) RunAndReturn(run func(teamId int) error) *MockClient_DeleteTeam_Call {
+func (_c *MockClient_ChangeOrgMemberRole_Call) RunAndReturn(run func(orgId string, userId int, role string) error) *MockClient_ChangeOrgMemberRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteWorkspace provides a mock function for the type MockClient
-func (_mock *MockClient) DeleteWorkspace(wsId int) error {
-	ret := _mock.Called(wsId)
+// ChangeTeamMemberRole provides a mock function for the type MockClient
+func (_mock *MockClient) ChangeTeamMemberRole(teamId int, userId int, role int) error {
+	ret := _mock.Called(teamId, userId, role)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteWorkspace")
+		panic("no return value specified for ChangeTeamMemberRole")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
-		r0 = returnFunc(wsId)
+	if returnFunc, ok := ret.Get(0).(func(int, int, int) error); ok {
+		r0 = returnFunc(teamId, userId, role)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClient_DeleteWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspace'
-type MockClient_DeleteWorkspace_Call struct {
+// MockClient_ChangeTeamMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeTeamMemberRole'
+type MockClient_ChangeTeamMemberRole_Call struct {
 	*mock.Call
 }
 
-// DeleteWorkspace is a helper method to define mock.On call
-//   - wsId int
-func (_e *MockClient_Expecter) DeleteWorkspace(wsId any) *MockClient_DeleteWorkspace_Call {
-	return &MockClient_DeleteWorkspace_Call{Call: _e.mock.On("DeleteWorkspace", wsId)}
+// ChangeTeamMemberRole is a helper method to define mock.On call
+//   - teamId int
+//   - userId int
+//   - role int
+func (_e *MockClient_Expecter) ChangeTeamMemberRole(teamId any, userId any, role any) *MockClient_ChangeTeamMemberRole_Call {
+	return &MockClient_ChangeTeamMemberRole_Call{Call: _e.mock.On("ChangeTeamMemberRole", teamId, userId, role)}
 }
 
-func (_c *MockClient_DeleteWorkspace_Call) Run(run func(wsId int)) *MockClient_DeleteWorkspace_Call {
+func (_c *MockClient_ChangeTeamMemberRole_Call) Run(run func(teamId int, userId int, role int)) *MockClient_ChangeTeamMemberRole_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_DeleteWorkspace_Call) Return(err error) *MockClient_DeleteWorkspace_Call {
+func (_c *MockClient_ChangeTeamMemberRole_Call) Return(err error) *MockClient_ChangeTeamMemberRole_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_DeleteWorkspace_Call) RunAndReturn(run func(wsId int) error) *MockClient_DeleteWorkspace_Call {
+func (_c *MockClient_ChangeTeamMemberRole_Call) RunAndReturn(run func(teamId int, userId int, role int) error) *MockClient_ChangeTeamMemberRole_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeployLandscape provides a mock function for the type MockClient
-func (_mock *MockClient) DeployLandscape(wsId int, profile string) error {
-	ret := _mock.Called(wsId, profile)
+// CreateDomain provides a mock function for the type MockClient
+func (_mock *MockClient) CreateDomain(teamId int, domainName string) (*api.Domain, error) {
+	ret := _mock.Called(teamId, domainName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeployLandscape")
+		panic("no return value specified for CreateDomain")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
-		r0 = returnFunc(wsId, profile)
+	var r0 *api.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (*api.Domain, error)); ok {
+		return returnFunc(teamId, domainName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) *api.Domain); ok {
+		r0 = returnFunc(teamId, domainName)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Domain)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(teamId, domainName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_DeployLandscape_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployLandscape'
-type MockClient_DeployLandscape_Call struct {
+// MockClient_CreateDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDomain'
+type MockClient_CreateDomain_Call struct {
 	*mock.Call
 }
 
-// DeployLandscape is a helper method to define mock.On call
-//   - wsId int
-//   - profile string
-func (_e *MockClient_Expecter) DeployLandscape(wsId any, profile any) *MockClient_DeployLandscape_Call {
-	return &MockClient_DeployLandscape_Call{Call: _e.mock.On("DeployLandscape", wsId, profile)}
+// CreateDomain is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+func (_e *MockClient_Expecter) CreateDomain(teamId any, domainName any) *MockClient_CreateDomain_Call {
+	return &MockClient_CreateDomain_Call{Call: _e.mock.On("CreateDomain", teamId, domainName)}
 }
 
-func (_c *MockClient_DeployLandscape_Call) Run(run func(wsId int, profile string)) *MockClient_DeployLandscape_Call {
+func (_c *MockClient_CreateDomain_Call) Run(run func(teamId int, domainName string)) *MockClient_CreateDomain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -393,37 +479,37 @@ func (_c *MockClient_DeployLandscape_Call) Run(run func(wsId int, profile string
 	return _c
 }
 
-func (_c *MockClient_DeployLandscape_Call) Return(err error) *MockClient_DeployLandscape_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_CreateDomain_Call) Return(v *api.Domain, err error) *MockClient_CreateDomain_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_DeployLandscape_Call) RunAndReturn(run func(wsId int, profile string) error) *MockClient_DeployLandscape_Call {
+func (_c *MockClient_CreateDomain_Call) RunAndReturn(run func(teamId int, domainName string) (*api.Domain, error)) *MockClient_CreateDomain_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeployWorkspace provides a mock function for the type MockClient
-func (_mock *MockClient) DeployWorkspace(args api.DeployWorkspaceArgs) (*api.Workspace, error) {
+// CreateManagedService provides a mock function for the type MockClient
+func (_mock *MockClient) CreateManagedService(args api.CreateManagedServiceArgs) (*api.ManagedService, error) {
 	ret := _mock.Called(args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeployWorkspace")
+		panic("no return value specified for CreateManagedService")
 	}
 
-	var r0 *api.Workspace
+	var r0 *api.ManagedService
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(api.DeployWorkspaceArgs) (*api.Workspace, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(api.CreateManagedServiceArgs) (*api.ManagedService, error)); ok {
 		return returnFunc(args)
 	}
-	if returnFunc, ok := ret.Get(0).(func(api.DeployWorkspaceArgs) *api.Workspace); ok {
+	if returnFunc, ok := ret.Get(0).(func(api.CreateManagedServiceArgs) *api.ManagedService); ok {
 		r0 = returnFunc(args)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*api.Workspace)
+			r0 = ret.Get(0).(*api.ManagedService)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(api.DeployWorkspaceArgs) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(api.CreateManagedServiceArgs) error); ok {
 		r1 = returnFunc(args)
 	} else {
 		r1 = ret.Error(1)
@@ -431,22 +517,22 @@ func (_mock *MockClient) DeployWorkspace(args api.DeployWorkspaceArgs) (*api.Wor
 	return r0, r1
 }
 
-// MockClient_DeployWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployWorkspace'
-type MockClient_DeployWorkspace_Call struct {
+// MockClient_CreateManagedService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateManagedService'
+type MockClient_CreateManagedService_Call struct {
 	*mock.Call
 }
 
-// DeployWorkspace is a helper method to define mock.On call
-//   - args api.DeployWorkspaceArgs
-func (_e *MockClient_Expecter) DeployWorkspace(args any) *MockClient_DeployWorkspace_Call {
-	return &MockClient_DeployWorkspace_Call{Call: _e.mock.On("DeployWorkspace", args)}
+// CreateManagedService is a helper method to define mock.On call
+//   - args api.CreateManagedServiceArgs
+func (_e *MockClient_Expecter) CreateManagedService(args any) *MockClient_CreateManagedService_Call {
+	return &MockClient_CreateManagedService_Call{Call: _e.mock.On("CreateManagedService", args)}
 }
 
-func (_c *MockClient_DeployWorkspace_Call) Run(run func(args api.DeployWorkspaceArgs)) *MockClient_DeployWorkspace_Call {
+func (_c *MockClient_CreateManagedService_Call) Run(run func(args api.CreateManagedServiceArgs)) *MockClient_CreateManagedService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 api.DeployWorkspaceArgs
+		var arg0 api.CreateManagedServiceArgs
 		if args[0] != nil {
-			arg0 = args[0].(api.DeployWorkspaceArgs)
+			arg0 = args[0].(api.CreateManagedServiceArgs)
 		}
 		run(
 			arg0,
@@ -455,141 +541,114 @@ func (_c *MockClient_DeployWorkspace_Call) Run(run func(args api.DeployWorkspace
 	return _c
 }
 
-func (_c *MockClient_DeployWorkspace_Call) Return(v *api.Workspace, err error) *MockClient_DeployWorkspace_Call {
+func (_c *MockClient_CreateManagedService_Call) Return(v *api.ManagedService, err error) *MockClient_CreateManagedService_Call {
 	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_DeployWorkspace_Call) RunAndReturn(run func(args api.DeployWorkspaceArgs) (*api.Workspace, error)) *MockClient_DeployWorkspace_Call {
+func (_c *MockClient_CreateManagedService_Call) RunAndReturn(run func(args api.CreateManagedServiceArgs) (*api.ManagedService, error)) *MockClient_CreateManagedService_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ExecCommand provides a mock function for the type MockClient
-func (_mock *MockClient) ExecCommand(workspaceId int, command string, workdir string, env map[string]string) (string, string, error) {
-	ret := _mock.Called(workspaceId, command, workdir, env)
+// CreateOrganization provides a mock function for the type MockClient
+func (_mock *MockClient) CreateOrganization(name string, adminEmail string) (*api.Organization, error) {
+	ret := _mock.Called(name, adminEmail)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ExecCommand")
+		panic("no return value specified for CreateOrganization")
 	}
 
-	var r0 string
-	var r1 string
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, string, map[string]string) (string, string, error)); ok {
-		return returnFunc(workspaceId, command, workdir, env)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int, string, string, map[string]string) string); ok {
-		r0 = returnFunc(workspaceId, command, workdir, env)
-	} else {
-		r0 = ret.Get(0).(string)
+	var r0 *api.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*api.Organization, error)); ok {
+		return returnFunc(name, adminEmail)
 	}
-	if returnFunc, ok := ret.Get(1).(func(int, string, string, map[string]string) string); ok {
-		r1 = returnFunc(workspaceId, command, workdir, env)
+	if returnFunc, ok := ret.Get(0).(func(string, string) *api.Organization); ok {
+		r0 = returnFunc(name, adminEmail)
 	} else {
-		r1 = ret.Get(1).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Organization)
+		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(int, string, string, map[string]string) error); ok {
-		r2 = returnFunc(workspaceId, command, workdir, env)
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(name, adminEmail)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockClient_ExecCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecCommand'
-type MockClient_ExecCommand_Call struct {
+// MockClient_CreateOrganization_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrganization'
+type MockClient_CreateOrganization_Call struct {
 	*mock.Call
 }
 
-// ExecCommand is a helper method to define mock.On call
-//   - workspaceId int
-//   - command string
-//   - workdir string
-//   - env map[string]string
-func (_e *MockClient_Expecter) ExecCommand(workspaceId any, command any, workdir any, env any) *MockClient_ExecCommand_Call {
-	return &MockClient_ExecCommand_Call{Call: _e.mock.On("ExecCommand", workspaceId, command, workdir, env)}
+// CreateOrganization is a helper method to define mock.On call
+//   - name string
+//   - adminEmail string
+func (_e *MockClient_Expecter) CreateOrganization(name any, adminEmail any) *MockClient_CreateOrganization_Call {
+	return &MockClient_CreateOrganization_Call{Call: _e.mock.On("CreateOrganization", name, adminEmail)}
 }
 
-func (_c *MockClient_ExecCommand_Call) Run(run func(workspaceId int, command string, workdir string, env map[string]string)) *MockClient_ExecCommand_Call {
+func (_c *MockClient_CreateOrganization_Call) Run(run func(name string, adminEmail string)) *MockClient_CreateOrganization_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].(string)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 map[string]string
-		if args[3] != nil {
-			arg3 = args[3].(map[string]string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ExecCommand_Call) Return(s string, s1 string, err error) *MockClient_ExecCommand_Call {
-	_c.Call.Return(s, s1, err)
+func (_c *MockClient_CreateOrganization_Call) Return(v *api.Organization, err error) *MockClient_CreateOrganization_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_ExecCommand_Call) RunAndReturn(run func(workspaceId int, command string, workdir string, env map[string]string) (string, string, error)) *MockClient_ExecCommand_Call {
+func (_c *MockClient_CreateOrganization_Call) RunAndReturn(run func(name string, adminEmail string) (*api.Organization, error)) *MockClient_CreateOrganization_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPipelineState provides a mock function for the type MockClient
-func (_mock *MockClient) GetPipelineState(wsId int, stage string) ([]api.PipelineStatus, error) {
-	ret := _mock.Called(wsId, stage)
+// CreateSharedVault provides a mock function for the type MockClient
+func (_mock *MockClient) CreateSharedVault(teamId int, name string) error {
+	ret := _mock.Called(teamId, name)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetPipelineState")
+		panic("no return value specified for CreateSharedVault")
 	}
 
-	var r0 []api.PipelineStatus
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int, string) ([]api.PipelineStatus, error)); ok {
-		return returnFunc(wsId, stage)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int, string) []api.PipelineStatus); ok {
-		r0 = returnFunc(wsId, stage)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.PipelineStatus)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
-		r1 = returnFunc(wsId, stage)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(teamId, name)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_GetPipelineState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineState'
-type MockClient_GetPipelineState_Call struct {
+// MockClient_CreateSharedVault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSharedVault'
+type MockClient_CreateSharedVault_Call struct {
 	*mock.Call
 }
 
-// GetPipelineState is a helper method to define mock.On call
-//   - wsId int
-//   - stage string
-func (_e *MockClient_Expecter) GetPipelineState(wsId any, stage any) *MockClient_GetPipelineState_Call {
-	return &MockClient_GetPipelineState_Call{Call: _e.mock.On("GetPipelineState", wsId, stage)}
+// CreateSharedVault is a helper method to define mock.On call
+//   - teamId int
+//   - name string
+func (_e *MockClient_Expecter) CreateSharedVault(teamId any, name any) *MockClient_CreateSharedVault_Call {
+	return &MockClient_CreateSharedVault_Call{Call: _e.mock.On("CreateSharedVault", teamId, name)}
 }
 
-func (_c *MockClient_GetPipelineState_Call) Run(run func(wsId int, stage string)) *MockClient_GetPipelineState_Call {
+func (_c *MockClient_CreateSharedVault_Call) Run(run func(teamId int, name string)) *MockClient_CreateSharedVault_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -607,107 +666,120 @@ func (_c *MockClient_GetPipelineState_Call) Run(run func(wsId int, stage string)
 	return _c
 }
 
-func (_c *MockClient_GetPipelineState_Call) Return(vs []api.PipelineStatus, err error) *MockClient_GetPipelineState_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockClient_CreateSharedVault_Call) Return(err error) *MockClient_CreateSharedVault_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_GetPipelineState_Call) RunAndReturn(run func(wsId int, stage string) ([]api.PipelineStatus, error)) *MockClient_GetPipelineState_Call {
+func (_c *MockClient_CreateSharedVault_Call) RunAndReturn(run func(teamId int, name string) error) *MockClient_CreateSharedVault_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorkspace provides a mock function for the type MockClient
-func (_mock *MockClient) GetWorkspace(workspaceId int) (api.Workspace, error) {
-	ret := _mock.Called(workspaceId)
+// CreateTeam provides a mock function for the type MockClient
+func (_mock *MockClient) CreateTeam(orgId string, name string, dcId int) (*api.Team, error) {
+	ret := _mock.Called(orgId, name, dcId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorkspace")
+		panic("no return value specified for CreateTeam")
 	}
 
-	var r0 api.Workspace
+	var r0 *api.Team
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) (api.Workspace, error)); ok {
-		return returnFunc(workspaceId)
+	if returnFunc, ok := ret.Get(0).(func(string, string, int) (*api.Team, error)); ok {
+		return returnFunc(orgId, name, dcId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) api.Workspace); ok {
-		r0 = returnFunc(workspaceId)
+	if returnFunc, ok := ret.Get(0).(func(string, string, int) *api.Team); ok {
+		r0 = returnFunc(orgId, name, dcId)
 	} else {
-		r0 = ret.Get(0).(api.Workspace)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Team)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(workspaceId)
+	if returnFunc, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = returnFunc(orgId, name, dcId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
-type MockClient_GetWorkspace_Call struct {
+// MockClient_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type MockClient_CreateTeam_Call struct {
 	*mock.Call
 }
 
-// GetWorkspace is a helper method to define mock.On call
-//   - workspaceId int
-func (_e *MockClient_Expecter) GetWorkspace(workspaceId any) *MockClient_GetWorkspace_Call {
-	return &MockClient_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", workspaceId)}
+// CreateTeam is a helper method to define mock.On call
+//   - orgId string
+//   - name string
+//   - dcId int
+func (_e *MockClient_Expecter) CreateTeam(orgId any, name any, dcId any) *MockClient_CreateTeam_Call {
+	return &MockClient_CreateTeam_Call{Call: _e.mock.On("CreateTeam", orgId, name, dcId)}
 }
 
-func (_c *MockClient_GetWorkspace_Call) Run(run func(workspaceId int)) *MockClient_GetWorkspace_Call {
+func (_c *MockClient_CreateTeam_Call) Run(run func(orgId string, name string, dcId int)) *MockClient_CreateTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GetWorkspace_Call) Return(v api.Workspace, err error) *MockClient_GetWorkspace_Call {
-	_c.Call.Return(v, err)
+func (_c *MockClient_CreateTeam_Call) Return(team *api.Team, err error) *MockClient_CreateTeam_Call {
+	_c.Call.Return(team, err)
 	return _c
 }
 
-func (_c *MockClient_GetWorkspace_Call) RunAndReturn(run func(workspaceId int) (api.Workspace, error)) *MockClient_GetWorkspace_Call {
+func (_c *MockClient_CreateTeam_Call) RunAndReturn(run func(orgId string, name string, dcId int) (*api.Team, error)) *MockClient_CreateTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GitPull provides a mock function for the type MockClient
-func (_mock *MockClient) GitPull(wsId int, remote string, branch string) error {
-	ret := _mock.Called(wsId, remote, branch)
+// DeleteDomain provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteDomain(teamId int, domainName string) error {
+	ret := _mock.Called(teamId, domainName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GitPull")
+		panic("no return value specified for DeleteDomain")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, string) error); ok {
-		r0 = returnFunc(wsId, remote, branch)
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(teamId, domainName)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClient_GitPull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GitPull'
-type MockClient_GitPull_Call struct {
+// MockClient_DeleteDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDomain'
+type MockClient_DeleteDomain_Call struct {
 	*mock.Call
 }
 
-// GitPull is a helper method to define mock.On call
-//   - wsId int
-//   - remote string
-//   - branch string
-func (_e *MockClient_Expecter) GitPull(wsId any, remote any, branch any) *MockClient_GitPull_Call {
-	return &MockClient_GitPull_Call{Call: _e.mock.On("GitPull", wsId, remote, branch)}
+// DeleteDomain is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+func (_e *MockClient_Expecter) DeleteDomain(teamId any, domainName any) *MockClient_DeleteDomain_Call {
+	return &MockClient_DeleteDomain_Call{Call: _e.mock.On("DeleteDomain", teamId, domainName)}
 }
 
-func (_c *MockClient_GitPull_Call) Run(run func(wsId int, remote string, branch string)) *MockClient_GitPull_Call {
+func (_c *MockClient_DeleteDomain_Call) Run(run func(teamId int, domainName string)) *MockClient_DeleteDomain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -717,410 +789,2682 @@ func (_c *MockClient_GitPull_Call) Run(run func(wsId int, remote string, branch
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_GitPull_Call) Return(err error) *MockClient_GitPull_Call {
+func (_c *MockClient_DeleteDomain_Call) Return(err error) *MockClient_DeleteDomain_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_GitPull_Call) RunAndReturn(run func(wsId int, remote string, branch string) error) *MockClient_GitPull_Call {
+func (_c *MockClient_DeleteDomain_Call) RunAndReturn(run func(teamId int, domainName string) error) *MockClient_DeleteDomain_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListBaseimages provides a mock function for the type MockClient
-func (_mock *MockClient) ListBaseimages() ([]api.Baseimage, error) {
-	ret := _mock.Called()
+// DeleteEnvVars provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteEnvVars(workspaceId int, names []string) error {
+	ret := _mock.Called(workspaceId, names)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListBaseimages")
+		panic("no return value specified for DeleteEnvVars")
 	}
 
-	var r0 []api.Baseimage
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]api.Baseimage, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() []api.Baseimage); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.Baseimage)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, []string) error); ok {
+		r0 = returnFunc(workspaceId, names)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_ListBaseimages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBaseimages'
-type MockClient_ListBaseimages_Call struct {
+// MockClient_DeleteEnvVars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteEnvVars'
+type MockClient_DeleteEnvVars_Call struct {
 	*mock.Call
 }
 
-// ListBaseimages is a helper method to define mock.On call
+// DeleteEnvVars is a helper method to define mock.On call
+//   - workspaceId int
+//   - names []string
+func (_e *MockClient_Expecter) DeleteEnvVars(workspaceId any, names any) *MockClient_DeleteEnvVars_Call {
+	return &MockClient_DeleteEnvVars_Call{Call: _e.mock.On("DeleteEnvVars", workspaceId, names)}
+}
+
+func (_c *MockClient_DeleteEnvVars_Call) Run(run func(workspaceId int, names []string)) *MockClient_DeleteEnvVars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteEnvVars_Call) Return(err error) *MockClient_DeleteEnvVars_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteEnvVars_Call) RunAndReturn(run func(workspaceId int, names []string) error) *MockClient_DeleteEnvVars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteManagedService provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteManagedService(serviceId string) error {
+	ret := _mock.Called(serviceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteManagedService")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(serviceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteManagedService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteManagedService'
+type MockClient_DeleteManagedService_Call struct {
+	*mock.Call
+}
+
+// DeleteManagedService is a helper method to define mock.On call
+//   - serviceId string
+func (_e *MockClient_Expecter) DeleteManagedService(serviceId any) *MockClient_DeleteManagedService_Call {
+	return &MockClient_DeleteManagedService_Call{Call: _e.mock.On("DeleteManagedService", serviceId)}
+}
+
+func (_c *MockClient_DeleteManagedService_Call) Run(run func(serviceId string)) *MockClient_DeleteManagedService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteManagedService_Call) Return(err error) *MockClient_DeleteManagedService_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteManagedService_Call) RunAndReturn(run func(serviceId string) error) *MockClient_DeleteManagedService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSharedSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSharedSecrets(teamId int, vaultName string, keys []string) error {
+	ret := _mock.Called(teamId, vaultName, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSharedSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, []string) error); ok {
+		r0 = returnFunc(teamId, vaultName, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSharedSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSharedSecrets'
+type MockClient_DeleteSharedSecrets_Call struct {
+	*mock.Call
+}
+
+// DeleteSharedSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - vaultName string
+//   - keys []string
+func (_e *MockClient_Expecter) DeleteSharedSecrets(teamId any, vaultName any, keys any) *MockClient_DeleteSharedSecrets_Call {
+	return &MockClient_DeleteSharedSecrets_Call{Call: _e.mock.On("DeleteSharedSecrets", teamId, vaultName, keys)}
+}
+
+func (_c *MockClient_DeleteSharedSecrets_Call) Run(run func(teamId int, vaultName string, keys []string)) *MockClient_DeleteSharedSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedSecrets_Call) Return(err error) *MockClient_DeleteSharedSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedSecrets_Call) RunAndReturn(run func(teamId int, vaultName string, keys []string) error) *MockClient_DeleteSharedSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSharedVault provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSharedVault(teamId int, name string) error {
+	ret := _mock.Called(teamId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSharedVault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(teamId, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSharedVault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSharedVault'
+type MockClient_DeleteSharedVault_Call struct {
+	*mock.Call
+}
+
+// DeleteSharedVault is a helper method to define mock.On call
+//   - teamId int
+//   - name string
+func (_e *MockClient_Expecter) DeleteSharedVault(teamId any, name any) *MockClient_DeleteSharedVault_Call {
+	return &MockClient_DeleteSharedVault_Call{Call: _e.mock.On("DeleteSharedVault", teamId, name)}
+}
+
+func (_c *MockClient_DeleteSharedVault_Call) Run(run func(teamId int, name string)) *MockClient_DeleteSharedVault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedVault_Call) Return(err error) *MockClient_DeleteSharedVault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedVault_Call) RunAndReturn(run func(teamId int, name string) error) *MockClient_DeleteSharedVault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSshKey provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSshKey(fingerprint string) error {
+	ret := _mock.Called(fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSshKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(fingerprint)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSshKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSshKey'
+type MockClient_DeleteSshKey_Call struct {
+	*mock.Call
+}
+
+// DeleteSshKey is a helper method to define mock.On call
+//   - fingerprint string
+func (_e *MockClient_Expecter) DeleteSshKey(fingerprint any) *MockClient_DeleteSshKey_Call {
+	return &MockClient_DeleteSshKey_Call{Call: _e.mock.On("DeleteSshKey", fingerprint)}
+}
+
+func (_c *MockClient_DeleteSshKey_Call) Run(run func(fingerprint string)) *MockClient_DeleteSshKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSshKey_Call) Return(err error) *MockClient_DeleteSshKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSshKey_Call) RunAndReturn(run func(fingerprint string) error) *MockClient_DeleteSshKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTeam provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteTeam(teamId int) error {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTeam")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTeam'
+type MockClient_DeleteTeam_Call struct {
+	*mock.Call
+}
+
+// DeleteTeam is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) DeleteTeam(teamId any) *MockClient_DeleteTeam_Call {
+	return &MockClient_DeleteTeam_Call{Call: _e.mock.On("DeleteTeam", teamId)}
+}
+
+func (_c *MockClient_DeleteTeam_Call) Run(run func(teamId int)) *MockClient_DeleteTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteTeam_Call) Return(err error) *MockClient_DeleteTeam_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteTeam_Call) RunAndReturn(run func(teamId int) error) *MockClient_DeleteTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteWorkspace(wsId int) error {
+	ret := _mock.Called(wsId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(wsId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspace'
+type MockClient_DeleteWorkspace_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspace is a helper method to define mock.On call
+//   - wsId int
+func (_e *MockClient_Expecter) DeleteWorkspace(wsId any) *MockClient_DeleteWorkspace_Call {
+	return &MockClient_DeleteWorkspace_Call{Call: _e.mock.On("DeleteWorkspace", wsId)}
+}
+
+func (_c *MockClient_DeleteWorkspace_Call) Run(run func(wsId int)) *MockClient_DeleteWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteWorkspace_Call) Return(err error) *MockClient_DeleteWorkspace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteWorkspace_Call) RunAndReturn(run func(wsId int) error) *MockClient_DeleteWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteWorkspaceSecrets(teamId int, workspaceId int, keys []string) error {
+	ret := _mock.Called(teamId, workspaceId, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, []string) error); ok {
+		r0 = returnFunc(teamId, workspaceId, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceSecrets'
+type MockClient_DeleteWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+//   - keys []string
+func (_e *MockClient_Expecter) DeleteWorkspaceSecrets(teamId any, workspaceId any, keys any) *MockClient_DeleteWorkspaceSecrets_Call {
+	return &MockClient_DeleteWorkspaceSecrets_Call{Call: _e.mock.On("DeleteWorkspaceSecrets", teamId, workspaceId, keys)}
+}
+
+func (_c *MockClient_DeleteWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int, keys []string)) *MockClient_DeleteWorkspaceSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteWorkspaceSecrets_Call) Return(err error) *MockClient_DeleteWorkspaceSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int, keys []string) error) *MockClient_DeleteWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployLandscape provides a mock function for the type MockClient
+func (_mock *MockClient) DeployLandscape(wsId int, profile string) error {
+	ret := _mock.Called(wsId, profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeployLandscape")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(wsId, profile)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeployLandscape_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployLandscape'
+type MockClient_DeployLandscape_Call struct {
+	*mock.Call
+}
+
+// DeployLandscape is a helper method to define mock.On call
+//   - wsId int
+//   - profile string
+func (_e *MockClient_Expecter) DeployLandscape(wsId any, profile any) *MockClient_DeployLandscape_Call {
+	return &MockClient_DeployLandscape_Call{Call: _e.mock.On("DeployLandscape", wsId, profile)}
+}
+
+func (_c *MockClient_DeployLandscape_Call) Run(run func(wsId int, profile string)) *MockClient_DeployLandscape_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeployLandscape_Call) Return(err error) *MockClient_DeployLandscape_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeployLandscape_Call) RunAndReturn(run func(wsId int, profile string) error) *MockClient_DeployLandscape_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) DeployWorkspace(args api.DeployWorkspaceArgs) (*api.Workspace, error) {
+	ret := _mock.Called(args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeployWorkspace")
+	}
+
+	var r0 *api.Workspace
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(api.DeployWorkspaceArgs) (*api.Workspace, error)); ok {
+		return returnFunc(args)
+	}
+	if returnFunc, ok := ret.Get(0).(func(api.DeployWorkspaceArgs) *api.Workspace); ok {
+		r0 = returnFunc(args)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Workspace)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(api.DeployWorkspaceArgs) error); ok {
+		r1 = returnFunc(args)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_DeployWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployWorkspace'
+type MockClient_DeployWorkspace_Call struct {
+	*mock.Call
+}
+
+// DeployWorkspace is a helper method to define mock.On call
+//   - args api.DeployWorkspaceArgs
+func (_e *MockClient_Expecter) DeployWorkspace(args any) *MockClient_DeployWorkspace_Call {
+	return &MockClient_DeployWorkspace_Call{Call: _e.mock.On("DeployWorkspace", args)}
+}
+
+func (_c *MockClient_DeployWorkspace_Call) Run(run func(args api.DeployWorkspaceArgs)) *MockClient_DeployWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 api.DeployWorkspaceArgs
+		if args[0] != nil {
+			arg0 = args[0].(api.DeployWorkspaceArgs)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeployWorkspace_Call) Return(v *api.Workspace, err error) *MockClient_DeployWorkspace_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_DeployWorkspace_Call) RunAndReturn(run func(args api.DeployWorkspaceArgs) (*api.Workspace, error)) *MockClient_DeployWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecCommand provides a mock function for the type MockClient
+func (_mock *MockClient) ExecCommand(workspaceId int, command string, workdir string, env map[string]string) (string, string, error) {
+	ret := _mock.Called(workspaceId, command, workdir, env)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecCommand")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, string, map[string]string) (string, string, error)); ok {
+		return returnFunc(workspaceId, command, workdir, env)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string, string, map[string]string) string); ok {
+		r0 = returnFunc(workspaceId, command, workdir, env)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string, string, map[string]string) string); ok {
+		r1 = returnFunc(workspaceId, command, workdir, env)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(int, string, string, map[string]string) error); ok {
+		r2 = returnFunc(workspaceId, command, workdir, env)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockClient_ExecCommand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecCommand'
+type MockClient_ExecCommand_Call struct {
+	*mock.Call
+}
+
+// ExecCommand is a helper method to define mock.On call
+//   - workspaceId int
+//   - command string
+//   - workdir string
+//   - env map[string]string
+func (_e *MockClient_Expecter) ExecCommand(workspaceId any, command any, workdir any, env any) *MockClient_ExecCommand_Call {
+	return &MockClient_ExecCommand_Call{Call: _e.mock.On("ExecCommand", workspaceId, command, workdir, env)}
+}
+
+func (_c *MockClient_ExecCommand_Call) Run(run func(workspaceId int, command string, workdir string, env map[string]string)) *MockClient_ExecCommand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 map[string]string
+		if args[3] != nil {
+			arg3 = args[3].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ExecCommand_Call) Return(s string, s1 string, err error) *MockClient_ExecCommand_Call {
+	_c.Call.Return(s, s1, err)
+	return _c
+}
+
+func (_c *MockClient_ExecCommand_Call) RunAndReturn(run func(workspaceId int, command string, workdir string, env map[string]string) (string, string, error)) *MockClient_ExecCommand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) GenerateWorkspaceSecrets(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error) {
+	ret := _mock.Called(teamId, workspaceId, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateWorkspaceSecrets")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, map[string]interface{}) (map[string]interface{}, error)); ok {
+		return returnFunc(teamId, workspaceId, policies)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, map[string]interface{}) map[string]interface{}); ok {
+		r0 = returnFunc(teamId, workspaceId, policies)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, map[string]interface{}) error); ok {
+		r1 = returnFunc(teamId, workspaceId, policies)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GenerateWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateWorkspaceSecrets'
+type MockClient_GenerateWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// GenerateWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+//   - policies map[string]interface{}
+func (_e *MockClient_Expecter) GenerateWorkspaceSecrets(teamId any, workspaceId any, policies any) *MockClient_GenerateWorkspaceSecrets_Call {
+	return &MockClient_GenerateWorkspaceSecrets_Call{Call: _e.mock.On("GenerateWorkspaceSecrets", teamId, workspaceId, policies)}
+}
+
+func (_c *MockClient_GenerateWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int, policies map[string]interface{})) *MockClient_GenerateWorkspaceSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 map[string]interface{}
+		if args[2] != nil {
+			arg2 = args[2].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GenerateWorkspaceSecrets_Call) Return(stringToIfaceVal map[string]interface{}, err error) *MockClient_GenerateWorkspaceSecrets_Call {
+	_c.Call.Return(stringToIfaceVal, err)
+	return _c
+}
+
+func (_c *MockClient_GenerateWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error)) *MockClient_GenerateWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDomain provides a mock function for the type MockClient
+func (_mock *MockClient) GetDomain(teamId int, domainName string) (*api.Domain, error) {
+	ret := _mock.Called(teamId, domainName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDomain")
+	}
+
+	var r0 *api.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (*api.Domain, error)); ok {
+		return returnFunc(teamId, domainName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) *api.Domain); ok {
+		r0 = returnFunc(teamId, domainName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Domain)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(teamId, domainName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDomain'
+type MockClient_GetDomain_Call struct {
+	*mock.Call
+}
+
+// GetDomain is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+func (_e *MockClient_Expecter) GetDomain(teamId any, domainName any) *MockClient_GetDomain_Call {
+	return &MockClient_GetDomain_Call{Call: _e.mock.On("GetDomain", teamId, domainName)}
+}
+
+func (_c *MockClient_GetDomain_Call) Run(run func(teamId int, domainName string)) *MockClient_GetDomain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetDomain_Call) Return(v *api.Domain, err error) *MockClient_GetDomain_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_GetDomain_Call) RunAndReturn(run func(teamId int, domainName string) (*api.Domain, error)) *MockClient_GetDomain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPipelineState provides a mock function for the type MockClient
+func (_mock *MockClient) GetPipelineState(wsId int, stage string) ([]api.PipelineStatus, error) {
+	ret := _mock.Called(wsId, stage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPipelineState")
+	}
+
+	var r0 []api.PipelineStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) ([]api.PipelineStatus, error)); ok {
+		return returnFunc(wsId, stage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) []api.PipelineStatus); ok {
+		r0 = returnFunc(wsId, stage)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.PipelineStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(wsId, stage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetPipelineState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPipelineState'
+type MockClient_GetPipelineState_Call struct {
+	*mock.Call
+}
+
+// GetPipelineState is a helper method to define mock.On call
+//   - wsId int
+//   - stage string
+func (_e *MockClient_Expecter) GetPipelineState(wsId any, stage any) *MockClient_GetPipelineState_Call {
+	return &MockClient_GetPipelineState_Call{Call: _e.mock.On("GetPipelineState", wsId, stage)}
+}
+
+func (_c *MockClient_GetPipelineState_Call) Run(run func(wsId int, stage string)) *MockClient_GetPipelineState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetPipelineState_Call) Return(vs []api.PipelineStatus, err error) *MockClient_GetPipelineState_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_GetPipelineState_Call) RunAndReturn(run func(wsId int, stage string) ([]api.PipelineStatus, error)) *MockClient_GetPipelineState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) GetWorkspace(workspaceId int) (api.Workspace, error) {
+	ret := _mock.Called(workspaceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspace")
+	}
+
+	var r0 api.Workspace
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (api.Workspace, error)); ok {
+		return returnFunc(workspaceId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) api.Workspace); ok {
+		r0 = returnFunc(workspaceId)
+	} else {
+		r0 = ret.Get(0).(api.Workspace)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(workspaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
+type MockClient_GetWorkspace_Call struct {
+	*mock.Call
+}
+
+// GetWorkspace is a helper method to define mock.On call
+//   - workspaceId int
+func (_e *MockClient_Expecter) GetWorkspace(workspaceId any) *MockClient_GetWorkspace_Call {
+	return &MockClient_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", workspaceId)}
+}
+
+func (_c *MockClient_GetWorkspace_Call) Run(run func(workspaceId int)) *MockClient_GetWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWorkspace_Call) Return(v api.Workspace, err error) *MockClient_GetWorkspace_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_GetWorkspace_Call) RunAndReturn(run func(workspaceId int) (api.Workspace, error)) *MockClient_GetWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GitPull provides a mock function for the type MockClient
+func (_mock *MockClient) GitPull(wsId int, remote string, branch string) error {
+	ret := _mock.Called(wsId, remote, branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GitPull")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, string) error); ok {
+		r0 = returnFunc(wsId, remote, branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_GitPull_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GitPull'
+type MockClient_GitPull_Call struct {
+	*mock.Call
+}
+
+// GitPull is a helper method to define mock.On call
+//   - wsId int
+//   - remote string
+//   - branch string
+func (_e *MockClient_Expecter) GitPull(wsId any, remote any, branch any) *MockClient_GitPull_Call {
+	return &MockClient_GitPull_Call{Call: _e.mock.On("GitPull", wsId, remote, branch)}
+}
+
+func (_c *MockClient_GitPull_Call) Run(run func(wsId int, remote string, branch string)) *MockClient_GitPull_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GitPull_Call) Return(err error) *MockClient_GitPull_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_GitPull_Call) RunAndReturn(run func(wsId int, remote string, branch string) error) *MockClient_GitPull_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBaseimages provides a mock function for the type MockClient
+func (_mock *MockClient) ListBaseimages() ([]api.Baseimage, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBaseimages")
+	}
+
+	var r0 []api.Baseimage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]api.Baseimage, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []api.Baseimage); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.Baseimage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListBaseimages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBaseimages'
+type MockClient_ListBaseimages_Call struct {
+	*mock.Call
+}
+
+// ListBaseimages is a helper method to define mock.On call
 func (_e *MockClient_Expecter) ListBaseimages() *MockClient_ListBaseimages_Call {
 	return &MockClient_ListBaseimages_Call{Call: _e.mock.On("ListBaseimages")}
 }
 
-func (_c *MockClient_ListBaseimages_Call) Run(run func()) *MockClient_ListBaseimages_Call {
+func (_c *MockClient_ListBaseimages_Call) Run(run func()) *MockClient_ListBaseimages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_ListBaseimages_Call) Return(vs []api.Baseimage, err error) *MockClient_ListBaseimages_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListBaseimages_Call) RunAndReturn(run func() ([]api.Baseimage, error)) *MockClient_ListBaseimages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDomains provides a mock function for the type MockClient
+func (_mock *MockClient) ListDomains(teamId int) ([]api.Domain, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDomains")
+	}
+
+	var r0 []api.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.Domain, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.Domain); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.Domain)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListDomains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDomains'
+type MockClient_ListDomains_Call struct {
+	*mock.Call
+}
+
+// ListDomains is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListDomains(teamId any) *MockClient_ListDomains_Call {
+	return &MockClient_ListDomains_Call{Call: _e.mock.On("ListDomains", teamId)}
+}
+
+func (_c *MockClient_ListDomains_Call) Run(run func(teamId int)) *MockClient_ListDomains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListDomains_Call) Return(vs []api.Domain, err error) *MockClient_ListDomains_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListDomains_Call) RunAndReturn(run func(teamId int) ([]api.Domain, error)) *MockClient_ListDomains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListEnvVars provides a mock function for the type MockClient
+func (_mock *MockClient) ListEnvVars(workspaceId int) ([]api.EnvVar, error) {
+	ret := _mock.Called(workspaceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListEnvVars")
+	}
+
+	var r0 []api.EnvVar
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.EnvVar, error)); ok {
+		return returnFunc(workspaceId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.EnvVar); ok {
+		r0 = returnFunc(workspaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.EnvVar)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(workspaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListEnvVars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListEnvVars'
+type MockClient_ListEnvVars_Call struct {
+	*mock.Call
+}
+
+// ListEnvVars is a helper method to define mock.On call
+//   - workspaceId int
+func (_e *MockClient_Expecter) ListEnvVars(workspaceId any) *MockClient_ListEnvVars_Call {
+	return &MockClient_ListEnvVars_Call{Call: _e.mock.On("ListEnvVars", workspaceId)}
+}
+
+func (_c *MockClient_ListEnvVars_Call) Run(run func(workspaceId int)) *MockClient_ListEnvVars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListEnvVars_Call) Return(vs []api.EnvVar, err error) *MockClient_ListEnvVars_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListEnvVars_Call) RunAndReturn(run func(workspaceId int) ([]api.EnvVar, error)) *MockClient_ListEnvVars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListManagedServiceProviders provides a mock function for the type MockClient
+func (_mock *MockClient) ListManagedServiceProviders(teamId int) ([]api.ManagedServiceProvider, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListManagedServiceProviders")
+	}
+
+	var r0 []api.ManagedServiceProvider
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.ManagedServiceProvider, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.ManagedServiceProvider); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.ManagedServiceProvider)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListManagedServiceProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListManagedServiceProviders'
+type MockClient_ListManagedServiceProviders_Call struct {
+	*mock.Call
+}
+
+// ListManagedServiceProviders is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListManagedServiceProviders(teamId any) *MockClient_ListManagedServiceProviders_Call {
+	return &MockClient_ListManagedServiceProviders_Call{Call: _e.mock.On("ListManagedServiceProviders", teamId)}
+}
+
+func (_c *MockClient_ListManagedServiceProviders_Call) Run(run func(teamId int)) *MockClient_ListManagedServiceProviders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListManagedServiceProviders_Call) Return(managedServiceProviders []api.ManagedServiceProvider, err error) *MockClient_ListManagedServiceProviders_Call {
+	_c.Call.Return(managedServiceProviders, err)
+	return _c
+}
+
+func (_c *MockClient_ListManagedServiceProviders_Call) RunAndReturn(run func(teamId int) ([]api.ManagedServiceProvider, error)) *MockClient_ListManagedServiceProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListManagedServices provides a mock function for the type MockClient
+func (_mock *MockClient) ListManagedServices(teamId int) ([]api.ManagedService, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListManagedServices")
+	}
+
+	var r0 []api.ManagedService
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.ManagedService, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.ManagedService); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.ManagedService)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListManagedServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListManagedServices'
+type MockClient_ListManagedServices_Call struct {
+	*mock.Call
+}
+
+// ListManagedServices is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListManagedServices(teamId any) *MockClient_ListManagedServices_Call {
+	return &MockClient_ListManagedServices_Call{Call: _e.mock.On("ListManagedServices", teamId)}
+}
+
+func (_c *MockClient_ListManagedServices_Call) Run(run func(teamId int)) *MockClient_ListManagedServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListManagedServices_Call) Return(vs []api.ManagedService, err error) *MockClient_ListManagedServices_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListManagedServices_Call) RunAndReturn(run func(teamId int) ([]api.ManagedService, error)) *MockClient_ListManagedServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrgMembers provides a mock function for the type MockClient
+func (_mock *MockClient) ListOrgMembers(orgId string) ([]api.OrgMember, error) {
+	ret := _mock.Called(orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrgMembers")
+	}
+
+	var r0 []api.OrgMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]api.OrgMember, error)); ok {
+		return returnFunc(orgId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []api.OrgMember); ok {
+		r0 = returnFunc(orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.OrgMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListOrgMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrgMembers'
+type MockClient_ListOrgMembers_Call struct {
+	*mock.Call
+}
+
+// ListOrgMembers is a helper method to define mock.On call
+//   - orgId string
+func (_e *MockClient_Expecter) ListOrgMembers(orgId any) *MockClient_ListOrgMembers_Call {
+	return &MockClient_ListOrgMembers_Call{Call: _e.mock.On("ListOrgMembers", orgId)}
+}
+
+func (_c *MockClient_ListOrgMembers_Call) Run(run func(orgId string)) *MockClient_ListOrgMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListOrgMembers_Call) Return(vs []api.OrgMember, err error) *MockClient_ListOrgMembers_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListOrgMembers_Call) RunAndReturn(run func(orgId string) ([]api.OrgMember, error)) *MockClient_ListOrgMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrganizations provides a mock function for the type MockClient
+func (_mock *MockClient) ListOrganizations() ([]api.Organization, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrganizations")
+	}
+
+	var r0 []api.Organization
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]api.Organization, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []api.Organization); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.Organization)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
+type MockClient_ListOrganizations_Call struct {
+	*mock.Call
+}
+
+// ListOrganizations is a helper method to define mock.On call
+func (_e *MockClient_Expecter) ListOrganizations() *MockClient_ListOrganizations_Call {
+	return &MockClient_ListOrganizations_Call{Call: _e.mock.On("ListOrganizations")}
+}
+
+func (_c *MockClient_ListOrganizations_Call) Run(run func()) *MockClient_ListOrganizations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_ListOrganizations_Call) Return(vs []api.Organization, err error) *MockClient_ListOrganizations_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListOrganizations_Call) RunAndReturn(run func() ([]api.Organization, error)) *MockClient_ListOrganizations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSharedSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) ListSharedSecrets(teamId int, vaultName string) ([]string, error) {
+	ret := _mock.Called(teamId, vaultName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSharedSecrets")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) ([]string, error)); ok {
+		return returnFunc(teamId, vaultName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) []string); ok {
+		r0 = returnFunc(teamId, vaultName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(teamId, vaultName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListSharedSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSharedSecrets'
+type MockClient_ListSharedSecrets_Call struct {
+	*mock.Call
+}
+
+// ListSharedSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - vaultName string
+func (_e *MockClient_Expecter) ListSharedSecrets(teamId any, vaultName any) *MockClient_ListSharedSecrets_Call {
+	return &MockClient_ListSharedSecrets_Call{Call: _e.mock.On("ListSharedSecrets", teamId, vaultName)}
+}
+
+func (_c *MockClient_ListSharedSecrets_Call) Run(run func(teamId int, vaultName string)) *MockClient_ListSharedSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListSharedSecrets_Call) Return(strings []string, err error) *MockClient_ListSharedSecrets_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockClient_ListSharedSecrets_Call) RunAndReturn(run func(teamId int, vaultName string) ([]string, error)) *MockClient_ListSharedSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSharedVaults provides a mock function for the type MockClient
+func (_mock *MockClient) ListSharedVaults(teamId int) ([]string, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSharedVaults")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]string, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []string); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListSharedVaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSharedVaults'
+type MockClient_ListSharedVaults_Call struct {
+	*mock.Call
+}
+
+// ListSharedVaults is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListSharedVaults(teamId any) *MockClient_ListSharedVaults_Call {
+	return &MockClient_ListSharedVaults_Call{Call: _e.mock.On("ListSharedVaults", teamId)}
+}
+
+func (_c *MockClient_ListSharedVaults_Call) Run(run func(teamId int)) *MockClient_ListSharedVaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListSharedVaults_Call) Return(strings []string, err error) *MockClient_ListSharedVaults_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockClient_ListSharedVaults_Call) RunAndReturn(run func(teamId int) ([]string, error)) *MockClient_ListSharedVaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSshKeys provides a mock function for the type MockClient
+func (_mock *MockClient) ListSshKeys() ([]api.SshKey, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSshKeys")
+	}
+
+	var r0 []api.SshKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]api.SshKey, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []api.SshKey); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.SshKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListSshKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSshKeys'
+type MockClient_ListSshKeys_Call struct {
+	*mock.Call
+}
+
+// ListSshKeys is a helper method to define mock.On call
+func (_e *MockClient_Expecter) ListSshKeys() *MockClient_ListSshKeys_Call {
+	return &MockClient_ListSshKeys_Call{Call: _e.mock.On("ListSshKeys")}
+}
+
+func (_c *MockClient_ListSshKeys_Call) Run(run func()) *MockClient_ListSshKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_ListSshKeys_Call) Return(vs []api.SshKey, err error) *MockClient_ListSshKeys_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListSshKeys_Call) RunAndReturn(run func() ([]api.SshKey, error)) *MockClient_ListSshKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeamMembers provides a mock function for the type MockClient
+func (_mock *MockClient) ListTeamMembers(teamId int) ([]api.TeamMember, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeamMembers")
+	}
+
+	var r0 []api.TeamMember
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.TeamMember, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.TeamMember); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.TeamMember)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeamMembers'
+type MockClient_ListTeamMembers_Call struct {
+	*mock.Call
+}
+
+// ListTeamMembers is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListTeamMembers(teamId any) *MockClient_ListTeamMembers_Call {
+	return &MockClient_ListTeamMembers_Call{Call: _e.mock.On("ListTeamMembers", teamId)}
+}
+
+func (_c *MockClient_ListTeamMembers_Call) Run(run func(teamId int)) *MockClient_ListTeamMembers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListTeamMembers_Call) Return(teamMembers []api.TeamMember, err error) *MockClient_ListTeamMembers_Call {
+	_c.Call.Return(teamMembers, err)
+	return _c
+}
+
+func (_c *MockClient_ListTeamMembers_Call) RunAndReturn(run func(teamId int) ([]api.TeamMember, error)) *MockClient_ListTeamMembers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTeams provides a mock function for the type MockClient
+func (_mock *MockClient) ListTeams(orgId string) ([]api.Team, error) {
+	ret := _mock.Called(orgId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTeams")
+	}
+
+	var r0 []api.Team
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]api.Team, error)); ok {
+		return returnFunc(orgId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []api.Team); ok {
+		r0 = returnFunc(orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.Team)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
+type MockClient_ListTeams_Call struct {
+	*mock.Call
+}
+
+// ListTeams is a helper method to define mock.On call
+//   - orgId string
+func (_e *MockClient_Expecter) ListTeams(orgId any) *MockClient_ListTeams_Call {
+	return &MockClient_ListTeams_Call{Call: _e.mock.On("ListTeams", orgId)}
+}
+
+func (_c *MockClient_ListTeams_Call) Run(run func(orgId string)) *MockClient_ListTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListTeams_Call) Return(teams []api.Team, err error) *MockClient_ListTeams_Call {
+	_c.Call.Return(teams, err)
+	return _c
+}
+
+func (_c *MockClient_ListTeams_Call) RunAndReturn(run func(orgId string) ([]api.Team, error)) *MockClient_ListTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspacePlans provides a mock function for the type MockClient
+func (_mock *MockClient) ListWorkspacePlans() ([]api.WorkspacePlan, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspacePlans")
+	}
+
+	var r0 []api.WorkspacePlan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() ([]api.WorkspacePlan, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() []api.WorkspacePlan); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.WorkspacePlan)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListWorkspacePlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspacePlans'
+type MockClient_ListWorkspacePlans_Call struct {
+	*mock.Call
+}
+
+// ListWorkspacePlans is a helper method to define mock.On call
+func (_e *MockClient_Expecter) ListWorkspacePlans() *MockClient_ListWorkspacePlans_Call {
+	return &MockClient_ListWorkspacePlans_Call{Call: _e.mock.On("ListWorkspacePlans")}
+}
+
+func (_c *MockClient_ListWorkspacePlans_Call) Run(run func()) *MockClient_ListWorkspacePlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_ListWorkspacePlans_Call) Return(vs []api.WorkspacePlan, err error) *MockClient_ListWorkspacePlans_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListWorkspacePlans_Call) RunAndReturn(run func() ([]api.WorkspacePlan, error)) *MockClient_ListWorkspacePlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) ListWorkspaceSecrets(teamId int, workspaceId int) ([]string, error) {
+	ret := _mock.Called(teamId, workspaceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaceSecrets")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) ([]string, error)); ok {
+		return returnFunc(teamId, workspaceId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) []string); ok {
+		r0 = returnFunc(teamId, workspaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(teamId, workspaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspaceSecrets'
+type MockClient_ListWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// ListWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+func (_e *MockClient_Expecter) ListWorkspaceSecrets(teamId any, workspaceId any) *MockClient_ListWorkspaceSecrets_Call {
+	return &MockClient_ListWorkspaceSecrets_Call{Call: _e.mock.On("ListWorkspaceSecrets", teamId, workspaceId)}
+}
+
+func (_c *MockClient_ListWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int)) *MockClient_ListWorkspaceSecrets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListWorkspaceSecrets_Call) Return(strings []string, err error) *MockClient_ListWorkspaceSecrets_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockClient_ListWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int) ([]string, error)) *MockClient_ListWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspaces provides a mock function for the type MockClient
+func (_mock *MockClient) ListWorkspaces(teamId int) ([]api.Workspace, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaces")
+	}
+
+	var r0 []api.Workspace
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]api.Workspace, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []api.Workspace); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]api.Workspace)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspaces'
+type MockClient_ListWorkspaces_Call struct {
+	*mock.Call
+}
+
+// ListWorkspaces is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListWorkspaces(teamId any) *MockClient_ListWorkspaces_Call {
+	return &MockClient_ListWorkspaces_Call{Call: _e.mock.On("ListWorkspaces", teamId)}
+}
+
+func (_c *MockClient_ListWorkspaces_Call) Run(run func(teamId int)) *MockClient_ListWorkspaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListWorkspaces_Call) Return(vs []api.Workspace, err error) *MockClient_ListWorkspaces_Call {
+	_c.Call.Return(vs, err)
+	return _c
+}
+
+func (_c *MockClient_ListWorkspaces_Call) RunAndReturn(run func(teamId int) ([]api.Workspace, error)) *MockClient_ListWorkspaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MigrateTeamToOrg provides a mock function for the type MockClient
+func (_mock *MockClient) MigrateTeamToOrg(teamId int, orgId string, force bool) error {
+	ret := _mock.Called(teamId, orgId, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MigrateTeamToOrg")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, bool) error); ok {
+		r0 = returnFunc(teamId, orgId, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_MigrateTeamToOrg_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MigrateTeamToOrg'
+type MockClient_MigrateTeamToOrg_Call struct {
+	*mock.Call
+}
+
+// MigrateTeamToOrg is a helper method to define mock.On call
+//   - teamId int
+//   - orgId string
+//   - force bool
+func (_e *MockClient_Expecter) MigrateTeamToOrg(teamId any, orgId any, force any) *MockClient_MigrateTeamToOrg_Call {
+	return &MockClient_MigrateTeamToOrg_Call{Call: _e.mock.On("MigrateTeamToOrg", teamId, orgId, force)}
+}
+
+func (_c *MockClient_MigrateTeamToOrg_Call) Run(run func(teamId int, orgId string, force bool)) *MockClient_MigrateTeamToOrg_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_MigrateTeamToOrg_Call) Return(err error) *MockClient_MigrateTeamToOrg_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_MigrateTeamToOrg_Call) RunAndReturn(run func(teamId int, orgId string, force bool) error) *MockClient_MigrateTeamToOrg_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanByName provides a mock function for the type MockClient
+func (_mock *MockClient) PlanByName(name string) (api.WorkspacePlan, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanByName")
+	}
+
+	var r0 api.WorkspacePlan
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (api.WorkspacePlan, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) api.WorkspacePlan); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Get(0).(api.WorkspacePlan)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_PlanByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanByName'
+type MockClient_PlanByName_Call struct {
+	*mock.Call
+}
+
+// PlanByName is a helper method to define mock.On call
+//   - name string
+func (_e *MockClient_Expecter) PlanByName(name any) *MockClient_PlanByName_Call {
+	return &MockClient_PlanByName_Call{Call: _e.mock.On("PlanByName", name)}
+}
+
+func (_c *MockClient_PlanByName_Call) Run(run func(name string)) *MockClient_PlanByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_PlanByName_Call) Return(v api.WorkspacePlan, err error) *MockClient_PlanByName_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_PlanByName_Call) RunAndReturn(run func(name string) (api.WorkspacePlan, error)) *MockClient_PlanByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveOrgMember provides a mock function for the type MockClient
+func (_mock *MockClient) RemoveOrgMember(orgId string, userId int) error {
+	ret := _mock.Called(orgId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveOrgMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = returnFunc(orgId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RemoveOrgMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveOrgMember'
+type MockClient_RemoveOrgMember_Call struct {
+	*mock.Call
+}
+
+// RemoveOrgMember is a helper method to define mock.On call
+//   - orgId string
+//   - userId int
+func (_e *MockClient_Expecter) RemoveOrgMember(orgId any, userId any) *MockClient_RemoveOrgMember_Call {
+	return &MockClient_RemoveOrgMember_Call{Call: _e.mock.On("RemoveOrgMember", orgId, userId)}
+}
+
+func (_c *MockClient_RemoveOrgMember_Call) Run(run func(orgId string, userId int)) *MockClient_RemoveOrgMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_RemoveOrgMember_Call) Return(err error) *MockClient_RemoveOrgMember_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_RemoveOrgMember_Call) RunAndReturn(run func(orgId string, userId int) error) *MockClient_RemoveOrgMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTeamMember provides a mock function for the type MockClient
+func (_mock *MockClient) RemoveTeamMember(teamId int, userId int) error {
+	ret := _mock.Called(teamId, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTeamMember")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(teamId, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type MockClient_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - teamId int
+//   - userId int
+func (_e *MockClient_Expecter) RemoveTeamMember(teamId any, userId any) *MockClient_RemoveTeamMember_Call {
+	return &MockClient_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", teamId, userId)}
+}
+
+func (_c *MockClient_RemoveTeamMember_Call) Run(run func(teamId int, userId int)) *MockClient_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ListBaseimages_Call) Return(vs []api.Baseimage, err error) *MockClient_ListBaseimages_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockClient_RemoveTeamMember_Call) Return(err error) *MockClient_RemoveTeamMember_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_ListBaseimages_Call) RunAndReturn(run func() ([]api.Baseimage, error)) *MockClient_ListBaseimages_Call {
+func (_c *MockClient_RemoveTeamMember_Call) RunAndReturn(run func(teamId int, userId int) error) *MockClient_RemoveTeamMember_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListOrganizations provides a mock function for the type MockClient
-func (_mock *MockClient) ListOrganizations() ([]api.Organization, error) {
-	ret := _mock.Called()
+// Request provides a mock function for the type MockClient
+func (_mock *MockClient) Request(method string, path string, query url.Values, body []byte) ([]byte, error) {
+	ret := _mock.Called(method, path, query, body)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListOrganizations")
+		panic("no return value specified for Request")
 	}
 
-	var r0 []api.Organization
+	var r0 []byte
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]api.Organization, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string, string, url.Values, []byte) ([]byte, error)); ok {
+		return returnFunc(method, path, query, body)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []api.Organization); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string, string, url.Values, []byte) []byte); ok {
+		r0 = returnFunc(method, path, query, body)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.Organization)
+			r0 = ret.Get(0).([]byte)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(string, string, url.Values, []byte) error); ok {
+		r1 = returnFunc(method, path, query, body)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_ListOrganizations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrganizations'
-type MockClient_ListOrganizations_Call struct {
+// MockClient_Request_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Request'
+type MockClient_Request_Call struct {
 	*mock.Call
 }
 
-// ListOrganizations is a helper method to define mock.On call
-func (_e *MockClient_Expecter) ListOrganizations() *MockClient_ListOrganizations_Call {
-	return &MockClient_ListOrganizations_Call{Call: _e.mock.On("ListOrganizations")}
+// Request is a helper method to define mock.On call
+//   - method string
+//   - path string
+//   - query url.Values
+//   - body []byte
+func (_e *MockClient_Expecter) Request(method any, path any, query any, body any) *MockClient_Request_Call {
+	return &MockClient_Request_Call{Call: _e.mock.On("Request", method, path, query, body)}
 }
 
-func (_c *MockClient_ListOrganizations_Call) Run(run func()) *MockClient_ListOrganizations_Call {
+func (_c *MockClient_Request_Call) Run(run func(method string, path string, query url.Values, body []byte)) *MockClient_Request_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 url.Values
+		if args[2] != nil {
+			arg2 = args[2].(url.Values)
+		}
+		var arg3 []byte
+		if args[3] != nil {
+			arg3 = args[3].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ListOrganizations_Call) Return(vs []api.Organization, err error) *MockClient_ListOrganizations_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockClient_Request_Call) Return(bytes []byte, err error) *MockClient_Request_Call {
+	_c.Call.Return(bytes, err)
 	return _c
 }
 
-func (_c *MockClient_ListOrganizations_Call) RunAndReturn(run func() ([]api.Organization, error)) *MockClient_ListOrganizations_Call {
+func (_c *MockClient_Request_Call) RunAndReturn(run func(method string, path string, query url.Values, body []byte) ([]byte, error)) *MockClient_Request_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTeamMembers provides a mock function for the type MockClient
-func (_mock *MockClient) ListTeamMembers(teamId int) ([]api.TeamMember, error) {
-	ret := _mock.Called(teamId)
+// ScaleLandscapeServices provides a mock function for the type MockClient
+func (_mock *MockClient) ScaleLandscapeServices(wsId int, services map[string]int) error {
+	ret := _mock.Called(wsId, services)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTeamMembers")
+		panic("no return value specified for ScaleLandscapeServices")
 	}
 
-	var r0 []api.TeamMember
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, map[string]int) error); ok {
+		r0 = returnFunc(wsId, services)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_ScaleLandscapeServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleLandscapeServices'
+type MockClient_ScaleLandscapeServices_Call struct {
+	*mock.Call
+}
+
+// ScaleLandscapeServices is a helper method to define mock.On call
+//   - wsId int
+//   - services map[string]int
+func (_e *MockClient_Expecter) ScaleLandscapeServices(wsId any, services any) *MockClient_ScaleLandscapeServices_Call {
+	return &MockClient_ScaleLandscapeServices_Call{Call: _e.mock.On("ScaleLandscapeServices", wsId, services)}
+}
+
+func (_c *MockClient_ScaleLandscapeServices_Call) Run(run func(wsId int, services map[string]int)) *MockClient_ScaleLandscapeServices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 map[string]int
+		if args[1] != nil {
+			arg1 = args[1].(map[string]int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ScaleLandscapeServices_Call) Return(err error) *MockClient_ScaleLandscapeServices_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_ScaleLandscapeServices_Call) RunAndReturn(run func(wsId int, services map[string]int) error) *MockClient_ScaleLandscapeServices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScaleWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) ScaleWorkspace(wsId int, replicas int) error {
+	ret := _mock.Called(wsId, replicas)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScaleWorkspace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
+		r0 = returnFunc(wsId, replicas)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_ScaleWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleWorkspace'
+type MockClient_ScaleWorkspace_Call struct {
+	*mock.Call
+}
+
+// ScaleWorkspace is a helper method to define mock.On call
+//   - wsId int
+//   - replicas int
+func (_e *MockClient_Expecter) ScaleWorkspace(wsId any, replicas any) *MockClient_ScaleWorkspace_Call {
+	return &MockClient_ScaleWorkspace_Call{Call: _e.mock.On("ScaleWorkspace", wsId, replicas)}
+}
+
+func (_c *MockClient_ScaleWorkspace_Call) Run(run func(wsId int, replicas int)) *MockClient_ScaleWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ScaleWorkspace_Call) Return(err error) *MockClient_ScaleWorkspace_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_ScaleWorkspace_Call) RunAndReturn(run func(wsId int, replicas int) error) *MockClient_ScaleWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleManagedServiceBackup provides a mock function for the type MockClient
+func (_mock *MockClient) ScheduleManagedServiceBackup(serviceId string) (*api.ManagedServiceBackup, error) {
+	ret := _mock.Called(serviceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleManagedServiceBackup")
+	}
+
+	var r0 *api.ManagedServiceBackup
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) ([]api.TeamMember, error)); ok {
-		return returnFunc(teamId)
+	if returnFunc, ok := ret.Get(0).(func(string) (*api.ManagedServiceBackup, error)); ok {
+		return returnFunc(serviceId)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int) []api.TeamMember); ok {
-		r0 = returnFunc(teamId)
+	if returnFunc, ok := ret.Get(0).(func(string) *api.ManagedServiceBackup); ok {
+		r0 = returnFunc(serviceId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.TeamMember)
+			r0 = ret.Get(0).(*api.ManagedServiceBackup)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(teamId)
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(serviceId)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockClient_ListTeamMembers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeamMembers'
-type MockClient_ListTeamMembers_Call struct {
+// MockClient_ScheduleManagedServiceBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleManagedServiceBackup'
+type MockClient_ScheduleManagedServiceBackup_Call struct {
 	*mock.Call
 }
 
-// ListTeamMembers is a helper method to define mock.On call
-//   - teamId int
-func (_e *MockClient_Expecter) ListTeamMembers(teamId any) *MockClient_ListTeamMembers_Call {
-	return &MockClient_ListTeamMembers_Call{Call: _e.mock.On("ListTeamMembers", teamId)}
+// ScheduleManagedServiceBackup is a helper method to define mock.On call
+//   - serviceId string
+func (_e *MockClient_Expecter) ScheduleManagedServiceBackup(serviceId any) *MockClient_ScheduleManagedServiceBackup_Call {
+	return &MockClient_ScheduleManagedServiceBackup_Call{Call: _e.mock.On("ScheduleManagedServiceBackup", serviceId)}
 }
 
-func (_c *MockClient_ListTeamMembers_Call) Run(run func(teamId int)) *MockClient_ListTeamMembers_Call {
+func (_c *MockClient_ScheduleManagedServiceBackup_Call) Run(run func(serviceId string)) *MockClient_ScheduleManagedServiceBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ScheduleManagedServiceBackup_Call) Return(v *api.ManagedServiceBackup, err error) *MockClient_ScheduleManagedServiceBackup_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_ScheduleManagedServiceBackup_Call) RunAndReturn(run func(serviceId string) (*api.ManagedServiceBackup, error)) *MockClient_ScheduleManagedServiceBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnvVarOnWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) SetEnvVarOnWorkspace(workspaceId int, vars map[string]string) error {
+	ret := _mock.Called(workspaceId, vars)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnvVarOnWorkspace")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, map[string]string) error); ok {
+		r0 = returnFunc(workspaceId, vars)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_SetEnvVarOnWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnvVarOnWorkspace'
+type MockClient_SetEnvVarOnWorkspace_Call struct {
+	*mock.Call
+}
+
+// SetEnvVarOnWorkspace is a helper method to define mock.On call
+//   - workspaceId int
+//   - vars map[string]string
+func (_e *MockClient_Expecter) SetEnvVarOnWorkspace(workspaceId any, vars any) *MockClient_SetEnvVarOnWorkspace_Call {
+	return &MockClient_SetEnvVarOnWorkspace_Call{Call: _e.mock.On("SetEnvVarOnWorkspace", workspaceId, vars)}
+}
+
+func (_c *MockClient_SetEnvVarOnWorkspace_Call) Run(run func(workspaceId int, vars map[string]string)) *MockClient_SetEnvVarOnWorkspace_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 map[string]string
+		if args[1] != nil {
+			arg1 = args[1].(map[string]string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ListTeamMembers_Call) Return(teamMembers []api.TeamMember, err error) *MockClient_ListTeamMembers_Call {
-	_c.Call.Return(teamMembers, err)
+func (_c *MockClient_SetEnvVarOnWorkspace_Call) Return(err error) *MockClient_SetEnvVarOnWorkspace_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_ListTeamMembers_Call) RunAndReturn(run func(teamId int) ([]api.TeamMember, error)) *MockClient_ListTeamMembers_Call {
+func (_c *MockClient_SetEnvVarOnWorkspace_Call) RunAndReturn(run func(workspaceId int, vars map[string]string) error) *MockClient_SetEnvVarOnWorkspace_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTeams provides a mock function for the type MockClient
-func (_mock *MockClient) ListTeams(orgId string) ([]api.Team, error) {
-	ret := _mock.Called(orgId)
+// StartPipelineStage provides a mock function for the type MockClient
+func (_mock *MockClient) StartPipelineStage(wsId int, profile string, stage string) error {
+	ret := _mock.Called(wsId, profile, stage)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTeams")
+		panic("no return value specified for StartPipelineStage")
 	}
 
-	var r0 []api.Team
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) ([]api.Team, error)); ok {
-		return returnFunc(orgId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) []api.Team); ok {
-		r0 = returnFunc(orgId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.Team)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(orgId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, string) error); ok {
+		r0 = returnFunc(wsId, profile, stage)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_ListTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTeams'
-type MockClient_ListTeams_Call struct {
+// MockClient_StartPipelineStage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPipelineStage'
+type MockClient_StartPipelineStage_Call struct {
 	*mock.Call
 }
 
-// ListTeams is a helper method to define mock.On call
-//   - orgId string
-func (_e *MockClient_Expecter) ListTeams(orgId any) *MockClient_ListTeams_Call {
-	return &MockClient_ListTeams_Call{Call: _e.mock.On("ListTeams", orgId)}
+// StartPipelineStage is a helper method to define mock.On call
+//   - wsId int
+//   - profile string
+//   - stage string
+func (_e *MockClient_Expecter) StartPipelineStage(wsId any, profile any, stage any) *MockClient_StartPipelineStage_Call {
+	return &MockClient_StartPipelineStage_Call{Call: _e.mock.On("StartPipelineStage", wsId, profile, stage)}
 }
 
-func (_c *MockClient_ListTeams_Call) Run(run func(orgId string)) *MockClient_ListTeams_Call {
+func (_c *MockClient_StartPipelineStage_Call) Run(run func(wsId int, profile string, stage string)) *MockClient_StartPipelineStage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 int
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ListTeams_Call) Return(teams []api.Team, err error) *MockClient_ListTeams_Call {
-	_c.Call.Return(teams, err)
+func (_c *MockClient_StartPipelineStage_Call) Return(err error) *MockClient_StartPipelineStage_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_ListTeams_Call) RunAndReturn(run func(orgId string) ([]api.Team, error)) *MockClient_ListTeams_Call {
+func (_c *MockClient_StartPipelineStage_Call) RunAndReturn(run func(wsId int, profile string, stage string) error) *MockClient_StartPipelineStage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListWorkspacePlans provides a mock function for the type MockClient
-func (_mock *MockClient) ListWorkspacePlans() ([]api.WorkspacePlan, error) {
-	ret := _mock.Called()
+// StopPipelineStage provides a mock function for the type MockClient
+func (_mock *MockClient) StopPipelineStage(wsId int, stage string) error {
+	ret := _mock.Called(wsId, stage)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListWorkspacePlans")
+		panic("no return value specified for StopPipelineStage")
 	}
 
-	var r0 []api.WorkspacePlan
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]api.WorkspacePlan, error)); ok {
-		return returnFunc()
-	}
-	if returnFunc, ok := ret.Get(0).(func() []api.WorkspacePlan); ok {
-		r0 = returnFunc()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.WorkspacePlan)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(wsId, stage)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_ListWorkspacePlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspacePlans'
-type MockClient_ListWorkspacePlans_Call struct {
+// MockClient_StopPipelineStage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopPipelineStage'
+type MockClient_StopPipelineStage_Call struct {
 	*mock.Call
 }
 
-// ListWorkspacePlans is a helper method to define mock.On call
-func (_e *MockClient_Expecter) ListWorkspacePlans() *MockClient_ListWorkspacePlans_Call {
-	return &MockClient_ListWorkspacePlans_Call{Call: _e.mock.On("ListWorkspacePlans")}
+// StopPipelineStage is a helper method to define mock.On call
+//   - wsId int
+//   - stage string
+func (_e *MockClient_Expecter) StopPipelineStage(wsId any, stage any) *MockClient_StopPipelineStage_Call {
+	return &MockClient_StopPipelineStage_Call{Call: _e.mock.On("StopPipelineStage", wsId, stage)}
 }
 
-func (_c *MockClient_ListWorkspacePlans_Call) Run(run func()) *MockClient_ListWorkspacePlans_Call {
+func (_c *MockClient_StopPipelineStage_Call) Run(run func(wsId int, stage string)) *MockClient_StopPipelineStage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ListWorkspacePlans_Call) Return(vs []api.WorkspacePlan, err error) *MockClient_ListWorkspacePlans_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockClient_StopPipelineStage_Call) Return(err error) *MockClient_StopPipelineStage_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_ListWorkspacePlans_Call) RunAndReturn(run func() ([]api.WorkspacePlan, error)) *MockClient_ListWorkspacePlans_Call {
+func (_c *MockClient_StopPipelineStage_Call) RunAndReturn(run func(wsId int, stage string) error) *MockClient_StopPipelineStage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListWorkspaces provides a mock function for the type MockClient
-func (_mock *MockClient) ListWorkspaces(teamId int) ([]api.Workspace, error) {
-	ret := _mock.Called(teamId)
+// StoreSharedSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) StoreSharedSecrets(teamId int, vaultName string, secrets map[string]string) error {
+	ret := _mock.Called(teamId, vaultName, secrets)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListWorkspaces")
+		panic("no return value specified for StoreSharedSecrets")
 	}
 
-	var r0 []api.Workspace
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int) ([]api.Workspace, error)); ok {
-		return returnFunc(teamId)
-	}
-	if returnFunc, ok := ret.Get(0).(func(int) []api.Workspace); ok {
-		r0 = returnFunc(teamId)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]api.Workspace)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
-		r1 = returnFunc(teamId)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, map[string]string) error); ok {
+		r0 = returnFunc(teamId, vaultName, secrets)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockClient_ListWorkspaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspaces'
-type MockClient_ListWorkspaces_Call struct {
+// MockClient_StoreSharedSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreSharedSecrets'
+type MockClient_StoreSharedSecrets_Call struct {
 	*mock.Call
 }
 
-// ListWorkspaces is a helper method to define mock.On call
+// StoreSharedSecrets is a helper method to define mock.On call
 //   - teamId int
-func (_e *MockClient_Expecter) ListWorkspaces(teamId any) *MockClient_ListWorkspaces_Call {
-	return &MockClient_ListWorkspaces_Call{Call: _e.mock.On("ListWorkspaces", teamId)}
+//   - vaultName string
+//   - secrets map[string]string
+func (_e *MockClient_Expecter) StoreSharedSecrets(teamId any, vaultName any, secrets any) *MockClient_StoreSharedSecrets_Call {
+	return &MockClient_StoreSharedSecrets_Call{Call: _e.mock.On("StoreSharedSecrets", teamId, vaultName, secrets)}
 }
 
-func (_c *MockClient_ListWorkspaces_Call) Run(run func(teamId int)) *MockClient_ListWorkspaces_Call {
+func (_c *MockClient_StoreSharedSecrets_Call) Run(run func(teamId int, vaultName string, secrets map[string]string)) *MockClient_StoreSharedSecrets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ListWorkspaces_Call) Return(vs []api.Workspace, err error) *MockClient_ListWorkspaces_Call {
-	_c.Call.Return(vs, err)
+func (_c *MockClient_StoreSharedSecrets_Call) Return(err error) *MockClient_StoreSharedSecrets_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_ListWorkspaces_Call) RunAndReturn(run func(teamId int) ([]api.Workspace, error)) *MockClient_ListWorkspaces_Call {
+func (_c *MockClient_StoreSharedSecrets_Call) RunAndReturn(run func(teamId int, vaultName string, secrets map[string]string) error) *MockClient_StoreSharedSecrets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RemoveTeamMember provides a mock function for the type MockClient
-func (_mock *MockClient) RemoveTeamMember(teamId int, userId int) error {
-	ret := _mock.Called(teamId, userId)
+// StoreWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) StoreWorkspaceSecrets(teamId int, workspaceId int, secrets map[string]string) error {
+	ret := _mock.Called(teamId, workspaceId, secrets)
 
 	if len(ret) == 0 {
-		panic("no return value specified for RemoveTeamMember")
+		panic("no return value specified for StoreWorkspaceSecrets")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
-		r0 = returnFunc(teamId, userId)
+	if returnFunc, ok := ret.Get(0).(func(int, int, map[string]string) error); ok {
+		r0 = returnFunc(teamId, workspaceId, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClient_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
-type MockClient_RemoveTeamMember_Call struct {
+// MockClient_StoreWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreWorkspaceSecrets'
+type MockClient_StoreWorkspaceSecrets_Call struct {
 	*mock.Call
 }
 
-// RemoveTeamMember is a helper method to define mock.On call
+// StoreWorkspaceSecrets is a helper method to define mock.On call
 //   - teamId int
-//   - userId int
-func (_e *MockClient_Expecter) RemoveTeamMember(teamId any, userId any) *MockClient_RemoveTeamMember_Call {
-	return &MockClient_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", teamId, userId)}
+//   - workspaceId int
+//   - secrets map[string]string
+func (_e *MockClient_Expecter) StoreWorkspaceSecrets(teamId any, workspaceId any, secrets any) *MockClient_StoreWorkspaceSecrets_Call {
+	return &MockClient_StoreWorkspaceSecrets_Call{Call: _e.mock.On("StoreWorkspaceSecrets", teamId, workspaceId, secrets)}
 }
 
-func (_c *MockClient_RemoveTeamMember_Call) Run(run func(teamId int, userId int)) *MockClient_RemoveTeamMember_Call {
+func (_c *MockClient_StoreWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int, secrets map[string]string)) *MockClient_StoreWorkspaceSecrets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -1130,119 +3474,129 @@ func (_c *MockClient_RemoveTeamMember_Call) Run(run func(teamId int, userId int)
 		if args[1] != nil {
 			arg1 = args[1].(int)
 		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_RemoveTeamMember_Call) Return(err error) *MockClient_RemoveTeamMember_Call {
+func (_c *MockClient_StoreWorkspaceSecrets_Call) Return(err error) *MockClient_StoreWorkspaceSecrets_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_RemoveTeamMember_Call) RunAndReturn(run func(teamId int, userId int) error) *MockClient_RemoveTeamMember_Call {
+func (_c *MockClient_StoreWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int, secrets map[string]string) error) *MockClient_StoreWorkspaceSecrets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ScaleLandscapeServices provides a mock function for the type MockClient
-func (_mock *MockClient) ScaleLandscapeServices(wsId int, services map[string]int) error {
-	ret := _mock.Called(wsId, services)
+// TeardownLandscape provides a mock function for the type MockClient
+func (_mock *MockClient) TeardownLandscape(wsId int) error {
+	ret := _mock.Called(wsId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ScaleLandscapeServices")
+		panic("no return value specified for TeardownLandscape")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, map[string]int) error); ok {
-		r0 = returnFunc(wsId, services)
+	if returnFunc, ok := ret.Get(0).(func(int) error); ok {
+		r0 = returnFunc(wsId)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockClient_ScaleLandscapeServices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleLandscapeServices'
-type MockClient_ScaleLandscapeServices_Call struct {
+// MockClient_TeardownLandscape_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TeardownLandscape'
+type MockClient_TeardownLandscape_Call struct {
 	*mock.Call
 }
 
-// ScaleLandscapeServices is a helper method to define mock.On call
+// TeardownLandscape is a helper method to define mock.On call
 //   - wsId int
-//   - services map[string]int
-func (_e *MockClient_Expecter) ScaleLandscapeServices(wsId any, services any) *MockClient_ScaleLandscapeServices_Call {
-	return &MockClient_ScaleLandscapeServices_Call{Call: _e.mock.On("ScaleLandscapeServices", wsId, services)}
+func (_e *MockClient_Expecter) TeardownLandscape(wsId any) *MockClient_TeardownLandscape_Call {
+	return &MockClient_TeardownLandscape_Call{Call: _e.mock.On("TeardownLandscape", wsId)}
 }
 
-func (_c *MockClient_ScaleLandscapeServices_Call) Run(run func(wsId int, services map[string]int)) *MockClient_ScaleLandscapeServices_Call {
+func (_c *MockClient_TeardownLandscape_Call) Run(run func(wsId int)) *MockClient_TeardownLandscape_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
-		var arg1 map[string]int
-		if args[1] != nil {
-			arg1 = args[1].(map[string]int)
-		}
 		run(
 			arg0,
-			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockClient_ScaleLandscapeServices_Call) Return(err error) *MockClient_ScaleLandscapeServices_Call {
+func (_c *MockClient_TeardownLandscape_Call) Return(err error) *MockClient_TeardownLandscape_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_ScaleLandscapeServices_Call) RunAndReturn(run func(wsId int, services map[string]int) error) *MockClient_ScaleLandscapeServices_Call {
+func (_c *MockClient_TeardownLandscape_Call) RunAndReturn(run func(wsId int) error) *MockClient_TeardownLandscape_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ScaleWorkspace provides a mock function for the type MockClient
-func (_mock *MockClient) ScaleWorkspace(wsId int, replicas int) error {
-	ret := _mock.Called(wsId, replicas)
+// UpdateManagedService provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateManagedService(serviceId string, args api.UpdateManagedServiceArgs) (*api.ManagedService, error) {
+	ret := _mock.Called(serviceId, args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ScaleWorkspace")
+		panic("no return value specified for UpdateManagedService")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, int) error); ok {
-		r0 = returnFunc(wsId, replicas)
+	var r0 *api.ManagedService
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, api.UpdateManagedServiceArgs) (*api.ManagedService, error)); ok {
+		return returnFunc(serviceId, args)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, api.UpdateManagedServiceArgs) *api.ManagedService); ok {
+		r0 = returnFunc(serviceId, args)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.ManagedService)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(string, api.UpdateManagedServiceArgs) error); ok {
+		r1 = returnFunc(serviceId, args)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_ScaleWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScaleWorkspace'
-type MockClient_ScaleWorkspace_Call struct {
+// MockClient_UpdateManagedService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateManagedService'
+type MockClient_UpdateManagedService_Call struct {
 	*mock.Call
 }
 
-// ScaleWorkspace is a helper method to define mock.On call
-//   - wsId int
-//   - replicas int
-func (_e *MockClient_Expecter) ScaleWorkspace(wsId any, replicas any) *MockClient_ScaleWorkspace_Call {
-	return &MockClient_ScaleWorkspace_Call{Call: _e.mock.On("ScaleWorkspace", wsId, replicas)}
+// UpdateManagedService is a helper method to define mock.On call
+//   - serviceId string
+//   - args api.UpdateManagedServiceArgs
+func (_e *MockClient_Expecter) UpdateManagedService(serviceId any, args any) *MockClient_UpdateManagedService_Call {
+	return &MockClient_UpdateManagedService_Call{Call: _e.mock.On("UpdateManagedService", serviceId, args)}
 }
 
-func (_c *MockClient_ScaleWorkspace_Call) Run(run func(wsId int, replicas int)) *MockClient_ScaleWorkspace_Call {
+func (_c *MockClient_UpdateManagedService_Call) Run(run func(serviceId string, args api.UpdateManagedServiceArgs)) *MockClient_UpdateManagedService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(int)
+			arg0 = args[0].(string)
 		}
-		var arg1 int
+		var arg1 api.UpdateManagedServiceArgs
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(api.UpdateManagedServiceArgs)
 		}
 		run(
 			arg0,
@@ -1252,54 +3606,54 @@ func (_c *MockClient_ScaleWorkspace_Call) Run(run func(wsId int, replicas int))
 	return _c
 }
 
-func (_c *MockClient_ScaleWorkspace_Call) Return(err error) *MockClient_ScaleWorkspace_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_UpdateManagedService_Call) Return(v *api.ManagedService, err error) *MockClient_UpdateManagedService_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_ScaleWorkspace_Call) RunAndReturn(run func(wsId int, replicas int) error) *MockClient_ScaleWorkspace_Call {
+func (_c *MockClient_UpdateManagedService_Call) RunAndReturn(run func(serviceId string, args api.UpdateManagedServiceArgs) (*api.ManagedService, error)) *MockClient_UpdateManagedService_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetEnvVarOnWorkspace provides a mock function for the type MockClient
-func (_mock *MockClient) SetEnvVarOnWorkspace(workspaceId int, vars map[string]string) error {
-	ret := _mock.Called(workspaceId, vars)
+// UpdateWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateWorkspace(wsId int, args api.UpdateWorkspaceArgs) error {
+	ret := _mock.Called(wsId, args)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SetEnvVarOnWorkspace")
+		panic("no return value specified for UpdateWorkspace")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, map[string]string) error); ok {
-		r0 = returnFunc(workspaceId, vars)
+	if returnFunc, ok := ret.Get(0).(func(int, api.UpdateWorkspaceArgs) error); ok {
+		r0 = returnFunc(wsId, args)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
-
-// MockClient_SetEnvVarOnWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnvVarOnWorkspace'
-type MockClient_SetEnvVarOnWorkspace_Call struct {
-	*mock.Call
-}
-
-// SetEnvVarOnWorkspace is a helper method to define mock.On call
-//   - workspaceId int
-//   - vars map[string]string
-func (_e *MockClient_Expecter) SetEnvVarOnWorkspace(workspaceId any, vars any) *MockClient_SetEnvVarOnWorkspace_Call {
-	return &MockClient_SetEnvVarOnWorkspace_Call{Call: _e.mock.On("SetEnvVarOnWorkspace", workspaceId, vars)}
+
+// MockClient_UpdateWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspace'
+type MockClient_UpdateWorkspace_Call struct {
+	*mock.Call
 }
 
-func (_c *MockClient_SetEnvVarOnWorkspace_Call) Run(run func(workspaceId int, vars map[string]string)) *MockClient_SetEnvVarOnWorkspace_Call {
+// UpdateWorkspace is a helper method to define mock.On call
+//   - wsId int
+//   - args api.UpdateWorkspaceArgs
+func (_e *MockClient_Expecter) UpdateWorkspace(wsId any, args any) *MockClient_UpdateWorkspace_Call {
+	return &MockClient_UpdateWorkspace_Call{Call: _e.mock.On("UpdateWorkspace", wsId, args)}
+}
+
+func (_c *MockClient_UpdateWorkspace_Call) Run(run func(wsId int, args api.UpdateWorkspaceArgs)) *MockClient_UpdateWorkspace_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
 			arg0 = args[0].(int)
 		}
-		var arg1 map[string]string
+		var arg1 api.UpdateWorkspaceArgs
 		if args[1] != nil {
-			arg1 = args[1].(map[string]string)
+			arg1 = args[1].(api.UpdateWorkspaceArgs)
 		}
 		run(
 			arg0,
@@ -1309,47 +3663,58 @@ func (_c *MockClient_SetEnvVarOnWorkspace_Call) Run(run func(workspaceId int, va
 	return _c
 }
 
-func (_c *MockClient_SetEnvVarOnWorkspace_Call) Return(err error) *MockClient_SetEnvVarOnWorkspace_Call {
+func (_c *MockClient_UpdateWorkspace_Call) Return(err error) *MockClient_UpdateWorkspace_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockClient_SetEnvVarOnWorkspace_Call) RunAndReturn(run func(workspaceId int, vars map[string]string) error) *MockClient_SetEnvVarOnWorkspace_Call {
+func (_c *MockClient_UpdateWorkspace_Call) RunAndReturn(run func(wsId int, args api.UpdateWorkspaceArgs) error) *MockClient_UpdateWorkspace_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StartPipelineStage provides a mock function for the type MockClient
-func (_mock *MockClient) StartPipelineStage(wsId int, profile string, stage string) error {
-	ret := _mock.Called(wsId, profile, stage)
+// UpdateWorkspaceConnections provides a mock function for the type MockClient
+func (_mock *MockClient) UpdateWorkspaceConnections(teamId int, domainName string, connections api.PathToWorkspaces) (*api.Domain, error) {
+	ret := _mock.Called(teamId, domainName, connections)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StartPipelineStage")
+		panic("no return value specified for UpdateWorkspaceConnections")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string, string) error); ok {
-		r0 = returnFunc(wsId, profile, stage)
+	var r0 *api.Domain
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, api.PathToWorkspaces) (*api.Domain, error)); ok {
+		return returnFunc(teamId, domainName, connections)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string, api.PathToWorkspaces) *api.Domain); ok {
+		r0 = returnFunc(teamId, domainName, connections)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.Domain)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, string, api.PathToWorkspaces) error); ok {
+		r1 = returnFunc(teamId, domainName, connections)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_StartPipelineStage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartPipelineStage'
-type MockClient_StartPipelineStage_Call struct {
+// MockClient_UpdateWorkspaceConnections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateWorkspaceConnections'
+type MockClient_UpdateWorkspaceConnections_Call struct {
 	*mock.Call
 }
 
-// StartPipelineStage is a helper method to define mock.On call
-//   - wsId int
-//   - profile string
-//   - stage string
-func (_e *MockClient_Expecter) StartPipelineStage(wsId any, profile any, stage any) *MockClient_StartPipelineStage_Call {
-	return &MockClient_StartPipelineStage_Call{Call: _e.mock.On("StartPipelineStage", wsId, profile, stage)}
+// UpdateWorkspaceConnections is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+//   - connections api.PathToWorkspaces
+func (_e *MockClient_Expecter) UpdateWorkspaceConnections(teamId any, domainName any, connections any) *MockClient_UpdateWorkspaceConnections_Call {
+	return &MockClient_UpdateWorkspaceConnections_Call{Call: _e.mock.On("UpdateWorkspaceConnections", teamId, domainName, connections)}
 }
 
-func (_c *MockClient_StartPipelineStage_Call) Run(run func(wsId int, profile string, stage string)) *MockClient_StartPipelineStage_Call {
+func (_c *MockClient_UpdateWorkspaceConnections_Call) Run(run func(teamId int, domainName string, connections api.PathToWorkspaces)) *MockClient_UpdateWorkspaceConnections_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -1359,9 +3724,9 @@ func (_c *MockClient_StartPipelineStage_Call) Run(run func(wsId int, profile str
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 api.PathToWorkspaces
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(api.PathToWorkspaces)
 		}
 		run(
 			arg0,
@@ -1372,46 +3737,57 @@ func (_c *MockClient_StartPipelineStage_Call) Run(run func(wsId int, profile str
 	return _c
 }
 
-func (_c *MockClient_StartPipelineStage_Call) Return(err error) *MockClient_StartPipelineStage_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_UpdateWorkspaceConnections_Call) Return(v *api.Domain, err error) *MockClient_UpdateWorkspaceConnections_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_StartPipelineStage_Call) RunAndReturn(run func(wsId int, profile string, stage string) error) *MockClient_StartPipelineStage_Call {
+func (_c *MockClient_UpdateWorkspaceConnections_Call) RunAndReturn(run func(teamId int, domainName string, connections api.PathToWorkspaces) (*api.Domain, error)) *MockClient_UpdateWorkspaceConnections_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// StopPipelineStage provides a mock function for the type MockClient
-func (_mock *MockClient) StopPipelineStage(wsId int, stage string) error {
-	ret := _mock.Called(wsId, stage)
+// VerifyDomain provides a mock function for the type MockClient
+func (_mock *MockClient) VerifyDomain(teamId int, domainName string) (*api.DomainVerificationStatus, error) {
+	ret := _mock.Called(teamId, domainName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for StopPipelineStage")
+		panic("no return value specified for VerifyDomain")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
-		r0 = returnFunc(wsId, stage)
+	var r0 *api.DomainVerificationStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) (*api.DomainVerificationStatus, error)); ok {
+		return returnFunc(teamId, domainName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) *api.DomainVerificationStatus); ok {
+		r0 = returnFunc(teamId, domainName)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*api.DomainVerificationStatus)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(teamId, domainName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockClient_StopPipelineStage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopPipelineStage'
-type MockClient_StopPipelineStage_Call struct {
+// MockClient_VerifyDomain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyDomain'
+type MockClient_VerifyDomain_Call struct {
 	*mock.Call
 }
 
-// StopPipelineStage is a helper method to define mock.On call
-//   - wsId int
-//   - stage string
-func (_e *MockClient_Expecter) StopPipelineStage(wsId any, stage any) *MockClient_StopPipelineStage_Call {
-	return &MockClient_StopPipelineStage_Call{Call: _e.mock.On("StopPipelineStage", wsId, stage)}
+// VerifyDomain is a helper method to define mock.On call
+//   - teamId int
+//   - domainName string
+func (_e *MockClient_Expecter) VerifyDomain(teamId any, domainName any) *MockClient_VerifyDomain_Call {
+	return &MockClient_VerifyDomain_Call{Call: _e.mock.On("VerifyDomain", teamId, domainName)}
 }
 
-func (_c *MockClient_StopPipelineStage_Call) Run(run func(wsId int, stage string)) *MockClient_StopPipelineStage_Call {
+func (_c *MockClient_VerifyDomain_Call) Run(run func(teamId int, domainName string)) *MockClient_VerifyDomain_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 int
 		if args[0] != nil {
@@ -1429,12 +3805,12 @@ func (_c *MockClient_StopPipelineStage_Call) Run(run func(wsId int, stage string
 	return _c
 }
 
-func (_c *MockClient_StopPipelineStage_Call) Return(err error) *MockClient_StopPipelineStage_Call {
-	_c.Call.Return(err)
+func (_c *MockClient_VerifyDomain_Call) Return(v *api.DomainVerificationStatus, err error) *MockClient_VerifyDomain_Call {
+	_c.Call.Return(v, err)
 	return _c
 }
 
-func (_c *MockClient_StopPipelineStage_Call) RunAndReturn(run func(wsId int, stage string) error) *MockClient_StopPipelineStage_Call {
+func (_c *MockClient_VerifyDomain_Call) RunAndReturn(run func(teamId int, domainName string) (*api.DomainVerificationStatus, error)) *MockClient_VerifyDomain_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1558,6 +3934,201 @@ func (_c *MockClient_WorkspaceStatus_Call) RunAndReturn(run func(workspaceId int
 	return _c
 }
 
+// NewMockCredentialStore creates a new instance of MockCredentialStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCredentialStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCredentialStore {
+	mock := &MockCredentialStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCredentialStore is an autogenerated mock type for the CredentialStore type
+type MockCredentialStore struct {
+	mock.Mock
+}
+
+type MockCredentialStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCredentialStore) EXPECT() *MockCredentialStore_Expecter {
+	return &MockCredentialStore_Expecter{mock: &_m.Mock}
+}
+
+// Delete provides a mock function for the type MockCredentialStore
+func (_mock *MockCredentialStore) Delete(apiUrl string) error {
+	ret := _mock.Called(apiUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(apiUrl)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCredentialStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type MockCredentialStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - apiUrl string
+func (_e *MockCredentialStore_Expecter) Delete(apiUrl any) *MockCredentialStore_Delete_Call {
+	return &MockCredentialStore_Delete_Call{Call: _e.mock.On("Delete", apiUrl)}
+}
+
+func (_c *MockCredentialStore_Delete_Call) Run(run func(apiUrl string)) *MockCredentialStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialStore_Delete_Call) Return(err error) *MockCredentialStore_Delete_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCredentialStore_Delete_Call) RunAndReturn(run func(apiUrl string) error) *MockCredentialStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type MockCredentialStore
+func (_mock *MockCredentialStore) Get(apiUrl string) (string, error) {
+	ret := _mock.Called(apiUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(apiUrl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(apiUrl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(apiUrl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCredentialStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockCredentialStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - apiUrl string
+func (_e *MockCredentialStore_Expecter) Get(apiUrl any) *MockCredentialStore_Get_Call {
+	return &MockCredentialStore_Get_Call{Call: _e.mock.On("Get", apiUrl)}
+}
+
+func (_c *MockCredentialStore_Get_Call) Run(run func(apiUrl string)) *MockCredentialStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialStore_Get_Call) Return(s string, err error) *MockCredentialStore_Get_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockCredentialStore_Get_Call) RunAndReturn(run func(apiUrl string) (string, error)) *MockCredentialStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function for the type MockCredentialStore
+func (_mock *MockCredentialStore) Set(apiUrl string, token string) error {
+	ret := _mock.Called(apiUrl, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(apiUrl, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCredentialStore_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockCredentialStore_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - apiUrl string
+//   - token string
+func (_e *MockCredentialStore_Expecter) Set(apiUrl any, token any) *MockCredentialStore_Set_Call {
+	return &MockCredentialStore_Set_Call{Call: _e.mock.On("Set", apiUrl, token)}
+}
+
+func (_c *MockCredentialStore_Set_Call) Run(run func(apiUrl string, token string)) *MockCredentialStore_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCredentialStore_Set_Call) Return(err error) *MockCredentialStore_Set_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCredentialStore_Set_Call) RunAndReturn(run func(apiUrl string, token string) error) *MockCredentialStore_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCommandExecutor creates a new instance of MockCommandExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCommandExecutor(t interface {
@@ -2020,3 +4591,69 @@ func (_c *MockEnv_GetWorkspaceId_Call) RunAndReturn(run func() (int, error)) *Mo
 	_c.Call.Return(run)
 	return _c
 }
+
+// LookupApiToken provides a mock function for the type MockEnv
+func (_mock *MockEnv) LookupApiToken(apiUrl string) (string, string, error) {
+	ret := _mock.Called(apiUrl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LookupApiToken")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, string, error)); ok {
+		return returnFunc(apiUrl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(apiUrl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) string); ok {
+		r1 = returnFunc(apiUrl)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	if returnFunc, ok := ret.Get(2).(func(string) error); ok {
+		r2 = returnFunc(apiUrl)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockEnv_LookupApiToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LookupApiToken'
+type MockEnv_LookupApiToken_Call struct {
+	*mock.Call
+}
+
+// LookupApiToken is a helper method to define mock.On call
+//   - apiUrl string
+func (_e *MockEnv_Expecter) LookupApiToken(apiUrl any) *MockEnv_LookupApiToken_Call {
+	return &MockEnv_LookupApiToken_Call{Call: _e.mock.On("LookupApiToken", apiUrl)}
+}
+
+func (_c *MockEnv_LookupApiToken_Call) Run(run func(apiUrl string)) *MockEnv_LookupApiToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEnv_LookupApiToken_Call) Return(token string, source string, err error) *MockEnv_LookupApiToken_Call {
+	_c.Call.Return(token, source, err)
+	return _c
+}
+
+func (_c *MockEnv_LookupApiToken_Call) RunAndReturn(run func(apiUrl string) (string, string, error)) *MockEnv_LookupApiToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
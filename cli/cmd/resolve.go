@@ -0,0 +1,203 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+// NameResolver resolves teams and workspaces given by ID or name to their IDs.
+type NameResolver struct {
+	Client Client
+	// Cache of resolved names, optional
+	Cache  *cs.NameCache
+	ApiUrl string
+	OrgId  string
+}
+
+// TeamId resolves a team ID or name.
+//
+// Returns [cserrors.NotFoundError] if no team with the name exists and
+// [cserrors.DuplicatedError] if multiple teams have the name.
+func (r *NameResolver) TeamId(ref string) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+
+	key := fmt.Sprintf("%s|%s|team|%s", r.ApiUrl, r.OrgId, ref)
+	if id, ok := r.cached(key); ok {
+		return id, nil
+	}
+
+	teams, err := r.Client.ListTeams(r.OrgId)
+	if err != nil {
+		return -1, fmt.Errorf("failed to list teams: %w", err)
+	}
+	ids := []int{}
+	for _, t := range teams {
+		if t.Name == ref {
+			ids = append(ids, t.Id)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return -1, cserrors.NotFound(fmt.Sprintf("no team with name %s found", ref))
+	case 1:
+		r.cache(key, ids[0])
+		return ids[0], nil
+	default:
+		return -1, cserrors.Duplicated(fmt.Sprintf("multiple teams with name %s found (IDs %s), use the team ID instead", ref, joinIds(ids)))
+	}
+}
+
+// WorkspaceId resolves a workspace ID, name or team-name/workspace-name.
+// Bare names are searched in the team with teamId, or in all teams if teamId is -1.
+//
+// Returns [cserrors.NotFoundError] if no workspace with the name exists and
+// [cserrors.DuplicatedError] if multiple workspaces have the name.
+func (r *NameResolver) WorkspaceId(ref string, teamId int) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+
+	name := ref
+	if teamRef, wsName, found := strings.Cut(ref, "/"); found {
+		var err error
+		teamId, err = r.TeamId(teamRef)
+		if err != nil {
+			return -1, err
+		}
+		name = wsName
+	}
+
+	key := fmt.Sprintf("%s|%s|workspace|%d|%s", r.ApiUrl, r.OrgId, teamId, name)
+	if id, ok := r.cached(key); ok {
+		return id, nil
+	}
+
+	teamIds := []int{teamId}
+	if teamId < 0 {
+		teams, err := r.Client.ListTeams(r.OrgId)
+		if err != nil {
+			return -1, fmt.Errorf("failed to list teams: %w", err)
+		}
+		teamIds = make([]int, len(teams))
+		for i, t := range teams {
+			teamIds[i] = t.Id
+		}
+	}
+
+	ids := []int{}
+	for _, t := range teamIds {
+		workspaces, err := r.Client.ListWorkspaces(t)
+		if err != nil {
+			return -1, fmt.Errorf("failed to list workspaces of team %d: %w", t, err)
+		}
+		ids = append(ids, workspaceIdsByName(workspaces, name)...)
+	}
+	switch len(ids) {
+	case 0:
+		return -1, cserrors.NotFound(fmt.Sprintf("no workspace with name %s found", name))
+	case 1:
+		r.cache(key, ids[0])
+		return ids[0], nil
+	default:
+		return -1, cserrors.Duplicated(fmt.Sprintf("multiple workspaces with name %s found (IDs %s), use team-name/workspace-name or the workspace ID instead", name, joinIds(ids)))
+	}
+}
+
+func (r *NameResolver) cached(key string) (int, bool) {
+	if r.Cache == nil {
+		return 0, false
+	}
+	return r.Cache.Get(key)
+}
+
+func (r *NameResolver) cache(key string, id int) {
+	if r.Cache == nil {
+		return
+	}
+	r.Cache.Set(key, id)
+	if err := r.Cache.Save(); err != nil {
+		log.Printf("Warning: %s\n", err)
+	}
+}
+
+func workspaceIdsByName(workspaces []api.Workspace, name string) []int {
+	ids := []int{}
+	for _, w := range workspaces {
+		if w.Name == name {
+			ids = append(ids, w.Id)
+		}
+	}
+	return ids
+}
+
+func joinIds(ids []int) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.Itoa(id)
+	}
+	return strings.Join(s, ", ")
+}
+
+// nameResolver returns a resolver using the configured API and the local name cache.
+func (o GlobalOptions) nameResolver() (*NameResolver, error) {
+	client, err := o.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+	orgId, err := o.GetOrgId()
+	if err != nil {
+		return nil, err
+	}
+	resolver := &NameResolver{Client: client, ApiUrl: o.GetApiUrl(), OrgId: orgId}
+	if path, err := cs.NameCachePath(); err == nil {
+		resolver.Cache = cs.LoadNameCache(path, time.Now)
+	}
+	return resolver, nil
+}
+
+func (o GlobalOptions) resolveTeamId(ref string) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+	resolver, err := o.nameResolver()
+	if err != nil {
+		return -1, err
+	}
+	return resolver.TeamId(ref)
+}
+
+func (o GlobalOptions) resolveWorkspaceId(ref string) (int, error) {
+	if id, err := strconv.Atoi(ref); err == nil {
+		return id, nil
+	}
+
+	// bare workspace names are searched in the selected team, if any
+	teamId := -1
+	if o.TeamId != -1 || o.Team != "" {
+		var err error
+		teamId, err = o.GetTeamId()
+		if err != nil {
+			return -1, err
+		}
+	} else if envTeamId, err := o.Env.GetTeamId(); err == nil && envTeamId >= 0 {
+		teamId = envTeamId
+	}
+
+	resolver, err := o.nameResolver()
+	if err != nil {
+		return -1, err
+	}
+	return resolver.WorkspaceId(ref, teamId)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+var _ = Describe("NameResolver", func() {
+	var (
+		mockClient *cmd.MockClient
+		r          *cmd.NameResolver
+		teams      []api.Team
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		r = &cmd.NameResolver{Client: mockClient, ApiUrl: "https://cloud.codesphere.com/api"}
+		teams = []api.Team{{Id: 1, Name: "frontend"}, {Id: 2, Name: "backend"}, {Id: 3, Name: "backend"}}
+	})
+
+	Context("TeamId", func() {
+		It("returns numeric IDs without API calls", func() {
+			Expect(r.TeamId("42")).To(Equal(42))
+		})
+
+		It("resolves team names", func() {
+			mockClient.EXPECT().ListTeams("").Return(teams, nil)
+			Expect(r.TeamId("frontend")).To(Equal(1))
+		})
+
+		It("fails for unknown teams", func() {
+			mockClient.EXPECT().ListTeams("").Return(teams, nil)
+			_, err := r.TeamId("unknown")
+			var notFound *cserrors.NotFoundError
+			Expect(err).To(BeAssignableToTypeOf(notFound))
+			Expect(err).To(MatchError("no team with name unknown found"))
+		})
+
+		It("fails for ambiguous team names", func() {
+			mockClient.EXPECT().ListTeams("").Return(teams, nil)
+			_, err := r.TeamId("backend")
+			var duplicated *cserrors.DuplicatedError
+			Expect(err).To(BeAssignableToTypeOf(duplicated))
+			Expect(err).To(MatchError("multiple teams with name backend found (IDs 2, 3), use the team ID instead"))
+		})
+	})
+
+	Context("WorkspaceId", func() {
+		It("resolves team-name/workspace-name", func() {
+			mockClient.EXPECT().ListTeams("").Return(teams, nil)
+			mockClient.EXPECT().ListWorkspaces(1).Return([]api.Workspace{{Id: 10, Name: "web"}, {Id: 11, Name: "docs"}}, nil)
+			Expect(r.WorkspaceId("frontend/docs", -1)).To(Equal(11))
+		})
+
+		It("searches bare names in the given team", func() {
+			mockClient.EXPECT().ListWorkspaces(2).Return([]api.Workspace{{Id: 20, Name: "api"}}, nil)
+			Expect(r.WorkspaceId("api", 2)).To(Equal(20))
+		})
+
+		It("searches bare names in all teams without a team", func() {
+			mockClient.EXPECT().ListTeams("").Return(teams, nil)
+			mockClient.EXPECT().ListWorkspaces(1).Return([]api.Workspace{{Id: 10, Name: "web"}}, nil)
+			mockClient.EXPECT().ListWorkspaces(2).Return([]api.Workspace{{Id: 20, Name: "api"}}, nil)
+			mockClient.EXPECT().ListWorkspaces(3).Return([]api.Workspace{{Id: 30, Name: "api"}}, nil)
+
+			_, err := r.WorkspaceId("api", -1)
+			Expect(err).To(MatchError("multiple workspaces with name api found (IDs 20, 30), use team-name/workspace-name or the workspace ID instead"))
+		})
+
+		It("fails for unknown workspaces", func() {
+			mockClient.EXPECT().ListWorkspaces(2).Return([]api.Workspace{}, nil)
+			_, err := r.WorkspaceId("api", 2)
+			Expect(err).To(MatchError("no workspace with name api found"))
+		})
+	})
+
+	It("caches resolved names", func() {
+		r.Cache = cs.LoadNameCache(filepath.Join(GinkgoT().TempDir(), "names.json"), time.Now)
+		mockClient.EXPECT().ListWorkspaces(2).Return([]api.Workspace{{Id: 20, Name: "api"}}, nil).Once()
+
+		Expect(r.WorkspaceId("api", 2)).To(Equal(20))
+		Expect(r.WorkspaceId("api", 2)).To(Equal(20))
+	})
+})
@@ -13,6 +13,9 @@ import (
 	deletecmd "github.com/codesphere-cloud/cs-go/cli/cmd/delete"
 	generatecmd "github.com/codesphere-cloud/cs-go/cli/cmd/generate"
 	listcmd "github.com/codesphere-cloud/cs-go/cli/cmd/list"
+	logscmd "github.com/codesphere-cloud/cs-go/cli/cmd/logs"
+	secretscmd "github.com/codesphere-cloud/cs-go/cli/cmd/secrets"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	startcmd "github.com/codesphere-cloud/cs-go/cli/cmd/start"
 	"github.com/codesphere-cloud/cs-go/pkg/cs"
 	"github.com/google/uuid"
@@ -23,12 +26,20 @@ type GlobalOptions struct {
 	ApiUrl      string
 	TeamId      int
 	WorkspaceId int
-	OrgId       string
-	Env         Env
-	Verbose     bool
+	// Team is the team ID or name given by flag, resolved by GetTeamId
+	Team string
+	// Workspace is the workspace ID, name or team-name/workspace-name given by flag, resolved by GetWorkspaceId
+	Workspace string
+	OrgId     string
+	Env       Env
+	Verbose   bool
 }
 
 func (o GlobalOptions) GetApiToken() (string, error) {
+	if o.ApiUrl != "" {
+		token, _, err := o.Env.LookupApiToken(o.ApiUrl)
+		return token, err
+	}
 	return o.Env.GetApiToken()
 }
 
@@ -38,6 +49,7 @@ func (o GlobalOptions) GetVerbose() bool {
 
 type Env interface {
 	GetApiToken() (string, error)
+	LookupApiToken(apiUrl string) (token string, source string, err error)
 	GetTeamId() (int, error)
 	GetWorkspaceId() (int, error)
 	GetOrgId() string
@@ -55,12 +67,15 @@ func (o GlobalOptions) GetTeamId() (int, error) {
 	if o.TeamId != -1 {
 		return o.TeamId, nil
 	}
+	if o.Team != "" {
+		return o.resolveTeamId(o.Team)
+	}
 	wsId, err := o.Env.GetTeamId()
 	if err != nil {
 		return -1, err
 	}
 	if wsId < 0 {
-		return -1, errors.New("team ID not set, use -t or CS_TEAM_ID to set it")
+		return -1, shared.ErrTeamIdNotSet
 	}
 	return wsId, nil
 }
@@ -69,6 +84,9 @@ func (o GlobalOptions) GetWorkspaceId() (int, error) {
 	if o.WorkspaceId != -1 {
 		return o.WorkspaceId, nil
 	}
+	if o.Workspace != "" {
+		return o.resolveWorkspaceId(o.Workspace)
+	}
 	wsId, err := o.Env.GetWorkspaceId()
 	if err != nil {
 		return -1, err
@@ -101,21 +119,24 @@ func GetRootCmd() *cobra.Command {
 	var rootCmd = &cobra.Command{
 		Use:               "cs",
 		Short:             "The Codesphere CLI",
-		Long:              `Manage and debug resources deployed in Codesphere via command line.`,
+		Long:              "Manage and debug resources deployed in Codesphere via command line.\n\n" + exitCodeHelp,
 		Args:              cobra.NoArgs,
 		DisableAutoGenTag: true,
 	}
 
-	opts := GlobalOptions{Env: cs.NewEnv()}
+	opts := GlobalOptions{Env: cs.NewEnv(), TeamId: -1, WorkspaceId: -1}
 
 	rootCmd.PersistentFlags().StringVarP(&opts.ApiUrl, "api", "a", "", "URL of Codesphere API (can also be CS_API)")
-	rootCmd.PersistentFlags().IntVarP(&opts.TeamId, "team", "t", -1, "Team ID (relevant for some commands, can also be CS_TEAM_ID)")
-	rootCmd.PersistentFlags().IntVarP(&opts.WorkspaceId, "workspace", "w", -1, "Workspace ID (relevant for some commands, can also be CS_WORKSPACE_ID)")
+	rootCmd.PersistentFlags().StringVarP(&opts.Team, "team", "t", "", "Team ID or name (relevant for some commands, can also be CS_TEAM_ID)")
+	rootCmd.PersistentFlags().StringVarP(&opts.Workspace, "workspace", "w", "", "Workspace ID, name or team-name/workspace-name (relevant for some commands, can also be CS_WORKSPACE_ID)")
 	rootCmd.PersistentFlags().BoolVarP(&opts.Verbose, "verbose", "v", false, "Verbose output")
 	rootCmd.PersistentFlags().StringVarP(&opts.OrgId, "org", "O", "", "Organization ID (relevant for some commands)")
+	_ = rootCmd.RegisterFlagCompletionFunc("team", shared.CompleteTeams(&opts))
+	_ = rootCmd.RegisterFlagCompletionFunc("workspace", shared.CompleteWorkspaces(&opts))
 
 	AddExecCmd(rootCmd, &opts)
 	listcmd.AddListCmd(rootCmd, &opts)
+	logscmd.AddLogsCmd(rootCmd, &opts)
 	AddVersionCmd(rootCmd)
 	AddLicensesCmd(rootCmd)
 	AddOpenCmd(rootCmd, &opts)
@@ -123,25 +144,49 @@ func GetRootCmd() *cobra.Command {
 	createcmd.AddCreateCmd(rootCmd, &opts)
 	deletecmd.AddDeleteCmd(rootCmd, &opts)
 	addcmd.AddAddCmd(rootCmd, &opts)
+	secretscmd.AddSecretsCmd(rootCmd, &opts)
+	secretscmd.AddVaultCmd(rootCmd, &opts)
 	AddMonitorCmd(rootCmd, &opts)
 	startcmd.AddStartCmd(rootCmd, &opts)
 	AddStopCmd(rootCmd, &opts)
 	AddGitCmd(rootCmd, &opts)
 	AddSyncCmd(rootCmd, &opts)
-	AddUpdateCmd(rootCmd)
+	AddTeardownCmd(rootCmd, &opts)
+	AddMigrateCmd(rootCmd, &opts)
+	AddConfigCmd(rootCmd)
+	AddAuthCmd(rootCmd, &opts)
+	AddUpdateCmd(rootCmd, &opts)
 	AddGoCmd(rootCmd)
 	AddWakeUpCmd(rootCmd, &opts)
 	AddCurlCmd(rootCmd, &opts)
+	AddApiCmd(rootCmd, &opts)
 	AddScaleCmd(rootCmd, &opts)
+	AddBackupCmd(rootCmd, &opts)
+	AddSshCmd(rootCmd, &opts)
+	AddVerifyCmd(rootCmd, &opts)
+	AddConnectCmd(rootCmd, &opts)
 	AddMcpCmd(rootCmd)
 	AddLegacyCmds(rootCmd, &opts)
 
+	markUsageErrors(rootCmd)
+
 	return rootCmd
 }
 
 func Execute() {
-	err := GetRootCmd().Execute()
+	err := ExecuteArgs(GetRootCmd(), os.Args[1:])
 	if err != nil {
-		os.Exit(1)
+		os.Exit(ExitCode(err))
+	}
+}
+
+// ExecuteArgs executes the root command with the given arguments, rejecting unknown subcommands of command groups.
+func ExecuteArgs(rootCmd *cobra.Command, args []string) error {
+	if group, err := unknownSubcommand(rootCmd, args); err != nil {
+		rootCmd.PrintErrln("Error:", err.Error())
+		rootCmd.PrintErrf("Run '%s --help' for usage.\n", group.CommandPath())
+		return err
 	}
+	rootCmd.SetArgs(args)
+	return rootCmd.Execute()
 }
@@ -0,0 +1,20 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import "github.com/codesphere-cloud/cs-go/api"
+
+type Client interface {
+	GetWorkspace(workspaceId int) (api.Workspace, error)
+	ListWorkspaceSecrets(teamId int, workspaceId int) ([]string, error)
+	StoreWorkspaceSecrets(teamId int, workspaceId int, secrets map[string]string) error
+	DeleteWorkspaceSecrets(teamId int, workspaceId int, keys []string) error
+	GenerateWorkspaceSecrets(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error)
+	ListSharedVaults(teamId int) ([]string, error)
+	CreateSharedVault(teamId int, name string) error
+	DeleteSharedVault(teamId int, name string) error
+	ListSharedSecrets(teamId int, vaultName string) ([]string, error)
+	StoreSharedSecrets(teamId int, vaultName string, secrets map[string]string) error
+	DeleteSharedSecrets(teamId int, vaultName string, keys []string) error
+}
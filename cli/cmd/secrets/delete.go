@@ -0,0 +1,68 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type SecretsDeleteCmd struct {
+	cmd           *cobra.Command
+	Opts          shared.RootOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddSecretsDeleteCmd(secrets *cobra.Command, opts shared.RootOptions) {
+	d := SecretsDeleteCmd{
+		cmd: &cobra.Command{
+			Use:   "delete KEY...",
+			Short: "Delete secrets of a workspace",
+			Long:  `Delete secrets from the vault of a workspace`,
+			Args:  cobra.MinimumNArgs(1),
+			Example: io.FormatExampleCommands("secrets delete", []io.Example{
+				{Cmd: "-w <workspace-id> DB_PASSWORD", Desc: "Delete a single secret"},
+				{Cmd: "-w <workspace-id> TLS_KEY TLS_CERT", Desc: "Delete multiple secrets"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	d.cmd.RunE = d.RunE
+	shared.AddCmd(secrets, d.cmd)
+}
+
+func (c *SecretsDeleteCmd) RunE(_ *cobra.Command, args []string) error {
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.DeleteSecrets(client, wsId, args)
+}
+
+func (c *SecretsDeleteCmd) DeleteSecrets(client Client, wsId int, keys []string) error {
+	teamId, err := workspaceTeamId(client, wsId)
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteWorkspaceSecrets(teamId, wsId, keys)
+	if err != nil {
+		return fmt.Errorf("failed to delete secrets: %w", err)
+	}
+
+	log.Printf("Deleted secrets %s from workspace %d\n", strings.Join(keys, ", "), wsId)
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type SecretsGenerateCmd struct {
+	cmd           *cobra.Command
+	Opts          shared.RootOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddSecretsGenerateCmd(secrets *cobra.Command, opts shared.RootOptions) {
+	g := SecretsGenerateCmd{
+		cmd: &cobra.Command{
+			Use:   "generate KEY[=POLICY]...",
+			Short: "Generate random secrets of a workspace",
+			Long: io.Long(`Generate random secrets in the vault of a workspace.
+
+				The generated values are stored in the vault only and are not printed.
+				An optional password policy can be passed as JSON object, otherwise the default policy is used.`),
+			Args: cobra.MinimumNArgs(1),
+			Example: io.FormatExampleCommands("secrets generate", []io.Example{
+				{Cmd: "-w <workspace-id> DB_PASSWORD", Desc: "Generate a secret using the default password policy"},
+				{Cmd: `-w <workspace-id> 'API_KEY={"length":64}'`, Desc: "Generate a secret using a custom password policy"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	g.cmd.RunE = g.RunE
+	shared.AddCmd(secrets, g.cmd)
+}
+
+func (c *SecretsGenerateCmd) RunE(_ *cobra.Command, args []string) error {
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	policies, err := ParsePasswordPolicies(args)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.ClientFactory(c.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.GenerateSecrets(client, wsId, policies)
+}
+
+func (c *SecretsGenerateCmd) GenerateSecrets(client Client, wsId int, policies map[string]interface{}) error {
+	teamId, err := workspaceTeamId(client, wsId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.GenerateWorkspaceSecrets(teamId, wsId, policies)
+	if err != nil {
+		return fmt.Errorf("failed to generate secrets: %w", err)
+	}
+
+	keys := make([]string, 0, len(policies))
+	for k := range policies {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	log.Printf("Generated secrets %s in workspace %d\n", strings.Join(keys, ", "), wsId)
+	return nil
+}
+
+// ParsePasswordPolicies parses arguments like ["KEY", `KEY2={"length":32}`] into a map of key -> policy.
+// Keys without policy get an empty policy, so the default policy of the vault is used.
+func ParsePasswordPolicies(args []string) (map[string]interface{}, error) {
+	policies := map[string]interface{}{}
+	for _, arg := range args {
+		key, policy, found := strings.Cut(arg, "=")
+		if key == "" {
+			return nil, fmt.Errorf("invalid format '%s', expected 'KEY' or 'KEY=POLICY'", arg)
+		}
+		parsed := map[string]interface{}{}
+		if found {
+			if err := json.Unmarshal([]byte(policy), &parsed); err != nil {
+				return nil, fmt.Errorf("invalid password policy for secret %s: %w", key, err)
+			}
+		}
+		policies[key] = parsed
+	}
+	return policies, nil
+}
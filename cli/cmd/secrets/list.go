@@ -0,0 +1,70 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type SecretsListCmd struct {
+	cmd           *cobra.Command
+	Opts          SecretsListOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type SecretsListOpts struct {
+	shared.RootOptions
+	OutputFormat shared.OutputFormat
+}
+
+func AddSecretsListCmd(secrets *cobra.Command, opts shared.RootOptions) {
+	l := SecretsListCmd{
+		cmd: &cobra.Command{
+			Use:   "list",
+			Short: "List secret keys of a workspace",
+			Long:  `List the keys of all secrets stored in the vault of a workspace`,
+			Example: io.FormatExampleCommands("secrets list", []io.Example{
+				{Cmd: "-w <workspace-id>", Desc: "List all secret keys of a workspace"},
+				{Cmd: "-w <workspace-id> -o json", Desc: "List all secret keys of a workspace in JSON format"},
+			}),
+		},
+		Opts:          SecretsListOpts{RootOptions: opts},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	addOutputFlag(l.cmd, &l.Opts.OutputFormat)
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(secrets, l.cmd)
+}
+
+func (c *SecretsListCmd) RunE(_ *cobra.Command, args []string) error {
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.ListSecrets(client, wsId)
+}
+
+func (c *SecretsListCmd) ListSecrets(client Client, wsId int) error {
+	teamId, err := workspaceTeamId(client, wsId)
+	if err != nil {
+		return err
+	}
+
+	keys, err := client.ListWorkspaceSecrets(teamId, wsId)
+	if err != nil {
+		return fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	return printKeys(keys, c.Opts.OutputFormat)
+}
@@ -0,0 +1,813 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package secrets
+
+import (
+	"github.com/codesphere-cloud/cs-go/api"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClient {
+	mock := &MockClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockClient is an autogenerated mock type for the Client type
+type MockClient struct {
+	mock.Mock
+}
+
+type MockClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClient) EXPECT() *MockClient_Expecter {
+	return &MockClient_Expecter{mock: &_m.Mock}
+}
+
+// CreateSharedVault provides a mock function for the type MockClient
+func (_mock *MockClient) CreateSharedVault(teamId int, name string) error {
+	ret := _mock.Called(teamId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSharedVault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(teamId, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_CreateSharedVault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSharedVault'
+type MockClient_CreateSharedVault_Call struct {
+	*mock.Call
+}
+
+// CreateSharedVault is a helper method to define mock.On call
+//   - teamId int
+//   - name string
+func (_e *MockClient_Expecter) CreateSharedVault(teamId any, name any) *MockClient_CreateSharedVault_Call {
+	return &MockClient_CreateSharedVault_Call{Call: _e.mock.On("CreateSharedVault", teamId, name)}
+}
+
+func (_c *MockClient_CreateSharedVault_Call) Run(run func(teamId int, name string)) *MockClient_CreateSharedVault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateSharedVault_Call) Return(err error) *MockClient_CreateSharedVault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_CreateSharedVault_Call) RunAndReturn(run func(teamId int, name string) error) *MockClient_CreateSharedVault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSharedSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSharedSecrets(teamId int, vaultName string, keys []string) error {
+	ret := _mock.Called(teamId, vaultName, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSharedSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, []string) error); ok {
+		r0 = returnFunc(teamId, vaultName, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSharedSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSharedSecrets'
+type MockClient_DeleteSharedSecrets_Call struct {
+	*mock.Call
+}
+
+// DeleteSharedSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - vaultName string
+//   - keys []string
+func (_e *MockClient_Expecter) DeleteSharedSecrets(teamId any, vaultName any, keys any) *MockClient_DeleteSharedSecrets_Call {
+	return &MockClient_DeleteSharedSecrets_Call{Call: _e.mock.On("DeleteSharedSecrets", teamId, vaultName, keys)}
+}
+
+func (_c *MockClient_DeleteSharedSecrets_Call) Run(run func(teamId int, vaultName string, keys []string)) *MockClient_DeleteSharedSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedSecrets_Call) Return(err error) *MockClient_DeleteSharedSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedSecrets_Call) RunAndReturn(run func(teamId int, vaultName string, keys []string) error) *MockClient_DeleteSharedSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSharedVault provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteSharedVault(teamId int, name string) error {
+	ret := _mock.Called(teamId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSharedVault")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) error); ok {
+		r0 = returnFunc(teamId, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteSharedVault_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSharedVault'
+type MockClient_DeleteSharedVault_Call struct {
+	*mock.Call
+}
+
+// DeleteSharedVault is a helper method to define mock.On call
+//   - teamId int
+//   - name string
+func (_e *MockClient_Expecter) DeleteSharedVault(teamId any, name any) *MockClient_DeleteSharedVault_Call {
+	return &MockClient_DeleteSharedVault_Call{Call: _e.mock.On("DeleteSharedVault", teamId, name)}
+}
+
+func (_c *MockClient_DeleteSharedVault_Call) Run(run func(teamId int, name string)) *MockClient_DeleteSharedVault_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedVault_Call) Return(err error) *MockClient_DeleteSharedVault_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteSharedVault_Call) RunAndReturn(run func(teamId int, name string) error) *MockClient_DeleteSharedVault_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) DeleteWorkspaceSecrets(teamId int, workspaceId int, keys []string) error {
+	ret := _mock.Called(teamId, workspaceId, keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWorkspaceSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, []string) error); ok {
+		r0 = returnFunc(teamId, workspaceId, keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_DeleteWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWorkspaceSecrets'
+type MockClient_DeleteWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// DeleteWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+//   - keys []string
+func (_e *MockClient_Expecter) DeleteWorkspaceSecrets(teamId any, workspaceId any, keys any) *MockClient_DeleteWorkspaceSecrets_Call {
+	return &MockClient_DeleteWorkspaceSecrets_Call{Call: _e.mock.On("DeleteWorkspaceSecrets", teamId, workspaceId, keys)}
+}
+
+func (_c *MockClient_DeleteWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int, keys []string)) *MockClient_DeleteWorkspaceSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_DeleteWorkspaceSecrets_Call) Return(err error) *MockClient_DeleteWorkspaceSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_DeleteWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int, keys []string) error) *MockClient_DeleteWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) GenerateWorkspaceSecrets(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error) {
+	ret := _mock.Called(teamId, workspaceId, policies)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateWorkspaceSecrets")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, map[string]interface{}) (map[string]interface{}, error)); ok {
+		return returnFunc(teamId, workspaceId, policies)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int, map[string]interface{}) map[string]interface{}); ok {
+		r0 = returnFunc(teamId, workspaceId, policies)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int, map[string]interface{}) error); ok {
+		r1 = returnFunc(teamId, workspaceId, policies)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GenerateWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateWorkspaceSecrets'
+type MockClient_GenerateWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// GenerateWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+//   - policies map[string]interface{}
+func (_e *MockClient_Expecter) GenerateWorkspaceSecrets(teamId any, workspaceId any, policies any) *MockClient_GenerateWorkspaceSecrets_Call {
+	return &MockClient_GenerateWorkspaceSecrets_Call{Call: _e.mock.On("GenerateWorkspaceSecrets", teamId, workspaceId, policies)}
+}
+
+func (_c *MockClient_GenerateWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int, policies map[string]interface{})) *MockClient_GenerateWorkspaceSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 map[string]interface{}
+		if args[2] != nil {
+			arg2 = args[2].(map[string]interface{})
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GenerateWorkspaceSecrets_Call) Return(stringToIfaceVal map[string]interface{}, err error) *MockClient_GenerateWorkspaceSecrets_Call {
+	_c.Call.Return(stringToIfaceVal, err)
+	return _c
+}
+
+func (_c *MockClient_GenerateWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int, policies map[string]interface{}) (map[string]interface{}, error)) *MockClient_GenerateWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkspace provides a mock function for the type MockClient
+func (_mock *MockClient) GetWorkspace(workspaceId int) (api.Workspace, error) {
+	ret := _mock.Called(workspaceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkspace")
+	}
+
+	var r0 api.Workspace
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) (api.Workspace, error)); ok {
+		return returnFunc(workspaceId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) api.Workspace); ok {
+		r0 = returnFunc(workspaceId)
+	} else {
+		r0 = ret.Get(0).(api.Workspace)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(workspaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_GetWorkspace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkspace'
+type MockClient_GetWorkspace_Call struct {
+	*mock.Call
+}
+
+// GetWorkspace is a helper method to define mock.On call
+//   - workspaceId int
+func (_e *MockClient_Expecter) GetWorkspace(workspaceId any) *MockClient_GetWorkspace_Call {
+	return &MockClient_GetWorkspace_Call{Call: _e.mock.On("GetWorkspace", workspaceId)}
+}
+
+func (_c *MockClient_GetWorkspace_Call) Run(run func(workspaceId int)) *MockClient_GetWorkspace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_GetWorkspace_Call) Return(v api.Workspace, err error) *MockClient_GetWorkspace_Call {
+	_c.Call.Return(v, err)
+	return _c
+}
+
+func (_c *MockClient_GetWorkspace_Call) RunAndReturn(run func(workspaceId int) (api.Workspace, error)) *MockClient_GetWorkspace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSharedSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) ListSharedSecrets(teamId int, vaultName string) ([]string, error) {
+	ret := _mock.Called(teamId, vaultName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSharedSecrets")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, string) ([]string, error)); ok {
+		return returnFunc(teamId, vaultName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, string) []string); ok {
+		r0 = returnFunc(teamId, vaultName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, string) error); ok {
+		r1 = returnFunc(teamId, vaultName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListSharedSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSharedSecrets'
+type MockClient_ListSharedSecrets_Call struct {
+	*mock.Call
+}
+
+// ListSharedSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - vaultName string
+func (_e *MockClient_Expecter) ListSharedSecrets(teamId any, vaultName any) *MockClient_ListSharedSecrets_Call {
+	return &MockClient_ListSharedSecrets_Call{Call: _e.mock.On("ListSharedSecrets", teamId, vaultName)}
+}
+
+func (_c *MockClient_ListSharedSecrets_Call) Run(run func(teamId int, vaultName string)) *MockClient_ListSharedSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListSharedSecrets_Call) Return(strings []string, err error) *MockClient_ListSharedSecrets_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockClient_ListSharedSecrets_Call) RunAndReturn(run func(teamId int, vaultName string) ([]string, error)) *MockClient_ListSharedSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSharedVaults provides a mock function for the type MockClient
+func (_mock *MockClient) ListSharedVaults(teamId int) ([]string, error) {
+	ret := _mock.Called(teamId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSharedVaults")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int) ([]string, error)); ok {
+		return returnFunc(teamId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int) []string); ok {
+		r0 = returnFunc(teamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int) error); ok {
+		r1 = returnFunc(teamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListSharedVaults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSharedVaults'
+type MockClient_ListSharedVaults_Call struct {
+	*mock.Call
+}
+
+// ListSharedVaults is a helper method to define mock.On call
+//   - teamId int
+func (_e *MockClient_Expecter) ListSharedVaults(teamId any) *MockClient_ListSharedVaults_Call {
+	return &MockClient_ListSharedVaults_Call{Call: _e.mock.On("ListSharedVaults", teamId)}
+}
+
+func (_c *MockClient_ListSharedVaults_Call) Run(run func(teamId int)) *MockClient_ListSharedVaults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListSharedVaults_Call) Return(strings []string, err error) *MockClient_ListSharedVaults_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockClient_ListSharedVaults_Call) RunAndReturn(run func(teamId int) ([]string, error)) *MockClient_ListSharedVaults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) ListWorkspaceSecrets(teamId int, workspaceId int) ([]string, error) {
+	ret := _mock.Called(teamId, workspaceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWorkspaceSecrets")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(int, int) ([]string, error)); ok {
+		return returnFunc(teamId, workspaceId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int, int) []string); ok {
+		r0 = returnFunc(teamId, workspaceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = returnFunc(teamId, workspaceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockClient_ListWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWorkspaceSecrets'
+type MockClient_ListWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// ListWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+func (_e *MockClient_Expecter) ListWorkspaceSecrets(teamId any, workspaceId any) *MockClient_ListWorkspaceSecrets_Call {
+	return &MockClient_ListWorkspaceSecrets_Call{Call: _e.mock.On("ListWorkspaceSecrets", teamId, workspaceId)}
+}
+
+func (_c *MockClient_ListWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int)) *MockClient_ListWorkspaceSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_ListWorkspaceSecrets_Call) Return(strings []string, err error) *MockClient_ListWorkspaceSecrets_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockClient_ListWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int) ([]string, error)) *MockClient_ListWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreSharedSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) StoreSharedSecrets(teamId int, vaultName string, secrets map[string]string) error {
+	ret := _mock.Called(teamId, vaultName, secrets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreSharedSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, string, map[string]string) error); ok {
+		r0 = returnFunc(teamId, vaultName, secrets)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_StoreSharedSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreSharedSecrets'
+type MockClient_StoreSharedSecrets_Call struct {
+	*mock.Call
+}
+
+// StoreSharedSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - vaultName string
+//   - secrets map[string]string
+func (_e *MockClient_Expecter) StoreSharedSecrets(teamId any, vaultName any, secrets any) *MockClient_StoreSharedSecrets_Call {
+	return &MockClient_StoreSharedSecrets_Call{Call: _e.mock.On("StoreSharedSecrets", teamId, vaultName, secrets)}
+}
+
+func (_c *MockClient_StoreSharedSecrets_Call) Run(run func(teamId int, vaultName string, secrets map[string]string)) *MockClient_StoreSharedSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_StoreSharedSecrets_Call) Return(err error) *MockClient_StoreSharedSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_StoreSharedSecrets_Call) RunAndReturn(run func(teamId int, vaultName string, secrets map[string]string) error) *MockClient_StoreSharedSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StoreWorkspaceSecrets provides a mock function for the type MockClient
+func (_mock *MockClient) StoreWorkspaceSecrets(teamId int, workspaceId int, secrets map[string]string) error {
+	ret := _mock.Called(teamId, workspaceId, secrets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StoreWorkspaceSecrets")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(int, int, map[string]string) error); ok {
+		r0 = returnFunc(teamId, workspaceId, secrets)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockClient_StoreWorkspaceSecrets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StoreWorkspaceSecrets'
+type MockClient_StoreWorkspaceSecrets_Call struct {
+	*mock.Call
+}
+
+// StoreWorkspaceSecrets is a helper method to define mock.On call
+//   - teamId int
+//   - workspaceId int
+//   - secrets map[string]string
+func (_e *MockClient_Expecter) StoreWorkspaceSecrets(teamId any, workspaceId any, secrets any) *MockClient_StoreWorkspaceSecrets_Call {
+	return &MockClient_StoreWorkspaceSecrets_Call{Call: _e.mock.On("StoreWorkspaceSecrets", teamId, workspaceId, secrets)}
+}
+
+func (_c *MockClient_StoreWorkspaceSecrets_Call) Run(run func(teamId int, workspaceId int, secrets map[string]string)) *MockClient_StoreWorkspaceSecrets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int
+		if args[0] != nil {
+			arg0 = args[0].(int)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockClient_StoreWorkspaceSecrets_Call) Return(err error) *MockClient_StoreWorkspaceSecrets_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockClient_StoreWorkspaceSecrets_Call) RunAndReturn(run func(teamId int, workspaceId int, secrets map[string]string) error) *MockClient_StoreWorkspaceSecrets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPrompt creates a new instance of MockPrompt. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPrompt(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPrompt {
+	mock := &MockPrompt{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockPrompt is an autogenerated mock type for the Prompt type
+type MockPrompt struct {
+	mock.Mock
+}
+
+type MockPrompt_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPrompt) EXPECT() *MockPrompt_Expecter {
+	return &MockPrompt_Expecter{mock: &_m.Mock}
+}
+
+// InputPrompt provides a mock function for the type MockPrompt
+func (_mock *MockPrompt) InputPrompt(prompt string) string {
+	ret := _mock.Called(prompt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InputPrompt")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(prompt)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockPrompt_InputPrompt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InputPrompt'
+type MockPrompt_InputPrompt_Call struct {
+	*mock.Call
+}
+
+// InputPrompt is a helper method to define mock.On call
+//   - prompt string
+func (_e *MockPrompt_Expecter) InputPrompt(prompt any) *MockPrompt_InputPrompt_Call {
+	return &MockPrompt_InputPrompt_Call{Call: _e.mock.On("InputPrompt", prompt)}
+}
+
+func (_c *MockPrompt_InputPrompt_Call) Run(run func(prompt string)) *MockPrompt_InputPrompt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPrompt_InputPrompt_Call) Return(s string) *MockPrompt_InputPrompt_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockPrompt_InputPrompt_Call) RunAndReturn(run func(prompt string) string) *MockPrompt_InputPrompt_Call {
+	_c.Call.Return(run)
+	return _c
+}
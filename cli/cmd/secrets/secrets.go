@@ -0,0 +1,66 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type SecretsCmd struct {
+	cmd *cobra.Command
+}
+
+func AddSecretsCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
+	secrets := SecretsCmd{
+		cmd: &cobra.Command{
+			Use:     "secrets",
+			Aliases: []string{"secret"},
+			Short:   "Manage workspace secrets",
+			Long: io.Long(`Manage secrets stored in the vault of a workspace.
+
+				Secret values are read from stdin or files, so they never end up in your shell history.
+				The API never returns secret values, only their keys can be listed.`),
+		},
+	}
+	shared.AddCmd(rootCmd, secrets.cmd)
+
+	AddSecretsSetCmd(secrets.cmd, opts)
+	AddSecretsListCmd(secrets.cmd, opts)
+	AddSecretsDeleteCmd(secrets.cmd, opts)
+	AddSecretsGenerateCmd(secrets.cmd, opts)
+}
+
+// workspaceTeamId returns the ID of the team owning the workspace, which is required by the vault API.
+func workspaceTeamId(client Client, wsId int) (int, error) {
+	ws, err := client.GetWorkspace(wsId)
+	if err != nil {
+		return -1, fmt.Errorf("failed to get workspace %d: %w", wsId, err)
+	}
+	return ws.TeamId, nil
+}
+
+func printKeys(keys []string, format shared.OutputFormat) error {
+	return io.PrintList(string(format), keys, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Key"})
+		for _, k := range keys {
+			t.AppendRow(table.Row{k})
+		}
+		return t
+	})
+}
+
+// addOutputFlag adds the output format flag of list commands, validating the format before the command runs.
+func addOutputFlag(cmd *cobra.Command, format *shared.OutputFormat) {
+	cmd.Flags().StringVarP((*string)(format), "output", "o", "table",
+		"Output format (table, json, yaml, csv, name, jsonpath=<template>, go-template=<template>, custom-columns=<HEADER:.field,...>)")
+	cmd.PreRunE = func(_ *cobra.Command, _ []string) error {
+		return io.ValidateListFormat(string(*format))
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type SecretsSetCmd struct {
+	cmd           *cobra.Command
+	Opts          SecretsSetOpts
+	Source        SecretSource
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type SecretsSetOpts struct {
+	shared.RootOptions
+	FromFiles []string
+}
+
+func AddSecretsSetCmd(secrets *cobra.Command, opts shared.RootOptions) {
+	s := SecretsSetCmd{
+		cmd: &cobra.Command{
+			Use:   "set [KEY]",
+			Short: "Store secrets of a workspace",
+			Long: io.Long(`Store secrets in the vault of a workspace, existing secrets with the same key are overwritten.
+
+				The value of KEY is read from stdin. Use --from-file to read values from files.`),
+			Args: cobra.MaximumNArgs(1),
+			Example: io.FormatExampleCommands("secrets set", []io.Example{
+				{Cmd: "-w <workspace-id> DB_PASSWORD", Desc: "Store a secret typed on stdin (finish with Ctrl+D)"},
+				{Cmd: "-w <workspace-id> DB_PASSWORD < password.txt", Desc: "Store a secret read from stdin"},
+				{Cmd: "-w <workspace-id> --from-file TLS_KEY=./tls.key --from-file TLS_CERT=./tls.crt", Desc: "Store secrets read from files"},
+			}),
+		},
+		Opts:          SecretsSetOpts{RootOptions: opts},
+		Source:        NewSecretSource(),
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	s.cmd.Flags().StringArrayVar(&s.Opts.FromFiles, "from-file", []string{}, "Secret in form KEY=path to read the value from a file, can be specified multiple times")
+	s.cmd.RunE = s.RunE
+	shared.AddCmd(secrets, s.cmd)
+}
+
+func (c *SecretsSetCmd) RunE(_ *cobra.Command, args []string) error {
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	stdinKey := ""
+	if len(args) > 0 {
+		stdinKey = args[0]
+	}
+	secrets, err := c.Source.ReadSecrets(stdinKey, c.Opts.FromFiles)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.SetSecrets(client, wsId, secrets)
+}
+
+func (c *SecretsSetCmd) SetSecrets(client Client, wsId int, secrets map[string]string) error {
+	teamId, err := workspaceTeamId(client, wsId)
+	if err != nil {
+		return err
+	}
+
+	err = client.StoreWorkspaceSecrets(teamId, wsId, secrets)
+	if err != nil {
+		return fmt.Errorf("failed to store secrets: %w", err)
+	}
+
+	log.Printf("Stored secrets %s in workspace %d\n", strings.Join(sortedKeys(secrets), ", "), wsId)
+	return nil
+}
+
+func sortedKeys(secrets map[string]string) []string {
+	keys := make([]string, 0, len(secrets))
+	for k := range secrets {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	secretscmd "github.com/codesphere-cloud/cs-go/cli/cmd/secrets"
+)
+
+var _ = Describe("SecretsSet", func() {
+	var (
+		mockClient *secretscmd.MockClient
+		c          *secretscmd.SecretsSetCmd
+		wsId       int
+		teamId     int
+	)
+
+	BeforeEach(func() {
+		mockClient = secretscmd.NewMockClient(GinkgoT())
+		wsId = 23
+		teamId = 42
+		c = &secretscmd.SecretsSetCmd{
+			Opts: secretscmd.SecretsSetOpts{
+				RootOptions: &cmd.GlobalOptions{WorkspaceId: wsId},
+			},
+		}
+	})
+
+	It("stores the secrets in the team of the workspace", func() {
+		secrets := map[string]string{"DB_PASSWORD": "s3cr3t"}
+		mockClient.EXPECT().GetWorkspace(wsId).Return(api.Workspace{Id: wsId, TeamId: teamId}, nil)
+		mockClient.EXPECT().StoreWorkspaceSecrets(teamId, wsId, secrets).Return(nil)
+
+		err := c.SetSecrets(mockClient, wsId, secrets)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns an error if the workspace can't be found", func() {
+		mockClient.EXPECT().GetWorkspace(wsId).Return(api.Workspace{}, errors.New("not found"))
+
+		err := c.SetSecrets(mockClient, wsId, map[string]string{"DB_PASSWORD": "s3cr3t"})
+		Expect(err).To(MatchError("failed to get workspace 23: not found"))
+	})
+})
+
+var _ = Describe("ParsePasswordPolicies", func() {
+	It("uses the default policy when none is given", func() {
+		policies, err := secretscmd.ParsePasswordPolicies([]string{"DB_PASSWORD", `API_KEY={"length":64}`})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policies).To(Equal(map[string]interface{}{
+			"DB_PASSWORD": map[string]interface{}{},
+			"API_KEY":     map[string]interface{}{"length": float64(64)},
+		}))
+	})
+
+	It("fails on invalid policies", func() {
+		_, err := secretscmd.ParsePasswordPolicies([]string{"API_KEY=64"})
+		Expect(err).To(MatchError(ContainSubstring("invalid password policy for secret API_KEY")))
+	})
+})
@@ -0,0 +1,64 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	goio "io"
+	"os"
+	"strings"
+)
+
+// SecretSource reads secret values from stdin or files instead of command line arguments.
+type SecretSource struct {
+	Stdin    goio.Reader
+	ReadFile func(name string) ([]byte, error)
+}
+
+func NewSecretSource() SecretSource {
+	return SecretSource{
+		Stdin:    os.Stdin,
+		ReadFile: os.ReadFile,
+	}
+}
+
+// ReadSecrets collects the secrets to store.
+// The value of stdinKey (if set) is read from stdin, a single trailing newline is removed.
+// fromFiles entries have the form KEY=path, the file content is used as value unchanged.
+func (s SecretSource) ReadSecrets(stdinKey string, fromFiles []string) (map[string]string, error) {
+	secrets := map[string]string{}
+
+	for _, f := range fromFiles {
+		key, path, found := strings.Cut(f, "=")
+		if !found || key == "" || path == "" {
+			return nil, fmt.Errorf("invalid format '%s', expected 'KEY=path'", f)
+		}
+		content, err := s.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret %s from file: %w", key, err)
+		}
+		secrets[key] = string(content)
+	}
+
+	if stdinKey != "" {
+		if _, ok := secrets[stdinKey]; ok {
+			return nil, fmt.Errorf("secret %s specified multiple times", stdinKey)
+		}
+		content, err := goio.ReadAll(s.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret %s from stdin: %w", stdinKey, err)
+		}
+		value := strings.TrimSuffix(strings.TrimSuffix(string(content), "\n"), "\r")
+		if value == "" {
+			return nil, fmt.Errorf("no value for secret %s provided on stdin", stdinKey)
+		}
+		secrets[stdinKey] = value
+	}
+
+	if len(secrets) == 0 {
+		return nil, errors.New("no secrets given, pass a key to read its value from stdin or use --from-file")
+	}
+	return secrets, nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"errors"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	secretscmd "github.com/codesphere-cloud/cs-go/cli/cmd/secrets"
+)
+
+var _ = Describe("SecretSource", func() {
+	var (
+		stdin  string
+		files  map[string]string
+		source secretscmd.SecretSource
+	)
+
+	BeforeEach(func() {
+		stdin = ""
+		files = map[string]string{}
+	})
+
+	JustBeforeEach(func() {
+		source = secretscmd.SecretSource{
+			Stdin: strings.NewReader(stdin),
+			ReadFile: func(name string) ([]byte, error) {
+				content, ok := files[name]
+				if !ok {
+					return nil, os.ErrNotExist
+				}
+				return []byte(content), nil
+			},
+		}
+	})
+
+	Context("value on stdin", func() {
+		BeforeEach(func() {
+			stdin = "s3cr3t\n"
+		})
+
+		It("reads the value and trims the trailing newline", func() {
+			secrets, err := source.ReadSecrets("DB_PASSWORD", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal(map[string]string{"DB_PASSWORD": "s3cr3t"}))
+		})
+	})
+
+	Context("empty stdin", func() {
+		It("returns an error", func() {
+			_, err := source.ReadSecrets("DB_PASSWORD", nil)
+			Expect(err).To(MatchError("no value for secret DB_PASSWORD provided on stdin"))
+		})
+	})
+
+	Context("values in files", func() {
+		BeforeEach(func() {
+			files["tls.key"] = "-----BEGIN KEY-----\n"
+		})
+
+		It("reads the file content unchanged", func() {
+			secrets, err := source.ReadSecrets("", []string{"TLS_KEY=tls.key"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal(map[string]string{"TLS_KEY": "-----BEGIN KEY-----\n"}))
+		})
+
+		It("returns an error if the file does not exist", func() {
+			_, err := source.ReadSecrets("", []string{"TLS_CERT=tls.crt"})
+			Expect(errors.Is(err, os.ErrNotExist)).To(BeTrue())
+		})
+
+		It("returns an error on invalid format", func() {
+			_, err := source.ReadSecrets("", []string{"tls.key"})
+			Expect(err).To(MatchError("invalid format 'tls.key', expected 'KEY=path'"))
+		})
+	})
+
+	It("returns an error if no secrets are given", func() {
+		_, err := source.ReadSecrets("", nil)
+		Expect(err).To(MatchError(ContainSubstring("no secrets given")))
+	})
+})
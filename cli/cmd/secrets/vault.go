@@ -0,0 +1,37 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type Prompt interface {
+	InputPrompt(prompt string) string
+}
+
+type VaultCmd struct {
+	cmd *cobra.Command
+}
+
+func AddVaultCmd(rootCmd *cobra.Command, opts shared.RootOptions) {
+	vault := VaultCmd{
+		cmd: &cobra.Command{
+			Use:   "vault",
+			Short: "Manage shared vaults",
+			Long: io.Long(`Manage shared vaults of a team and the secrets stored in them.
+
+				Shared vaults hold secrets which can be used by multiple workspaces of a team.
+				Secret values are read from stdin or files, so they never end up in your shell history.`),
+		},
+	}
+	shared.AddCmd(rootCmd, vault.cmd)
+
+	AddVaultCreateCmd(vault.cmd, opts)
+	AddVaultListCmd(vault.cmd, opts)
+	AddVaultDeleteCmd(vault.cmd, opts)
+	AddVaultStoreCmd(vault.cmd, opts)
+}
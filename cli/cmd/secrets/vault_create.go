@@ -0,0 +1,57 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+	"log"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type VaultCreateCmd struct {
+	cmd           *cobra.Command
+	Opts          shared.RootOptions
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+func AddVaultCreateCmd(vault *cobra.Command, opts shared.RootOptions) {
+	c := VaultCreateCmd{
+		cmd: &cobra.Command{
+			Use:   "create NAME",
+			Short: "Create shared vault",
+			Long:  `Create a shared vault in a team`,
+			Args:  cobra.ExactArgs(1),
+			Example: io.FormatExampleCommands("vault create", []io.Example{
+				{Cmd: "-t <team-id> production", Desc: "Create a shared vault named production"},
+			}),
+		},
+		Opts:          opts,
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	c.cmd.RunE = c.RunE
+	shared.AddCmd(vault, c.cmd)
+}
+
+func (c *VaultCreateCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	err = client.CreateSharedVault(teamId, args[0])
+	if err != nil {
+		return fmt.Errorf("failed to create shared vault: %w", err)
+	}
+
+	log.Printf("Shared vault %s created in team %d\n", args[0], teamId)
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type VaultDeleteCmd struct {
+	cmd           *cobra.Command
+	Opts          VaultDeleteOpts
+	Prompt        Prompt
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type VaultDeleteOpts struct {
+	shared.RootOptions
+	Confirmed bool
+}
+
+func AddVaultDeleteCmd(vault *cobra.Command, opts shared.RootOptions) {
+	d := VaultDeleteCmd{
+		cmd: &cobra.Command{
+			Use:   "delete NAME [KEY...]",
+			Short: "Delete shared vault or its secrets",
+			Long: io.Long(`Delete secrets from a shared vault.
+
+				When no keys are given, the whole vault is deleted after confirmation.
+				Confirmation can be given interactively or with the --yes flag`),
+			Args: cobra.MinimumNArgs(1),
+			Example: io.FormatExampleCommands("vault delete", []io.Example{
+				{Cmd: "-t <team-id> production DB_PASSWORD", Desc: "Delete a secret from the production vault"},
+				{Cmd: "-t <team-id> production", Desc: "Delete the production vault after interactive confirmation"},
+				{Cmd: "-t <team-id> production --yes", Desc: "Delete the production vault without confirmation"},
+			}),
+		},
+		Opts:          VaultDeleteOpts{RootOptions: opts},
+		Prompt:        &io.Prompt{},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	d.cmd.Flags().BoolVar(&d.Opts.Confirmed, "yes", false, "Confirm deletion of the shared vault")
+	d.cmd.RunE = d.RunE
+	shared.AddCmd(vault, d.cmd)
+}
+
+func (c *VaultDeleteCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	if len(args) > 1 {
+		return c.DeleteVaultSecrets(client, teamId, args[0], args[1:])
+	}
+	return c.DeleteVault(client, teamId, args[0])
+}
+
+func (c *VaultDeleteCmd) DeleteVault(client Client, teamId int, vaultName string) error {
+	if !c.Opts.Confirmed {
+		log.Printf("Please confirm deletion of shared vault '%s' in team %d and all its secrets by entering its name:\n", vaultName, teamId)
+		confirmation := c.Prompt.InputPrompt("Confirmation delete")
+
+		if confirmation != vaultName {
+			return errors.New("confirmation failed")
+		}
+	}
+
+	err := client.DeleteSharedVault(teamId, vaultName)
+	if err != nil {
+		return fmt.Errorf("failed to delete shared vault: %w", err)
+	}
+
+	log.Printf("Shared vault %s deleted successfully\n", vaultName)
+	return nil
+}
+
+func (c *VaultDeleteCmd) DeleteVaultSecrets(client Client, teamId int, vaultName string, keys []string) error {
+	err := client.DeleteSharedSecrets(teamId, vaultName, keys)
+	if err != nil {
+		return fmt.Errorf("failed to delete secrets from shared vault %s: %w", vaultName, err)
+	}
+
+	log.Printf("Deleted secrets %s from shared vault %s\n", strings.Join(keys, ", "), vaultName)
+	return nil
+}
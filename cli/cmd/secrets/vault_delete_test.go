@@ -0,0 +1,55 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	secretscmd "github.com/codesphere-cloud/cs-go/cli/cmd/secrets"
+)
+
+var _ = Describe("VaultDelete", func() {
+	var (
+		mockClient *secretscmd.MockClient
+		mockPrompt *secretscmd.MockPrompt
+		c          *secretscmd.VaultDeleteCmd
+		teamId     int
+	)
+
+	BeforeEach(func() {
+		mockClient = secretscmd.NewMockClient(GinkgoT())
+		mockPrompt = secretscmd.NewMockPrompt(GinkgoT())
+		teamId = 42
+		c = &secretscmd.VaultDeleteCmd{
+			Opts: secretscmd.VaultDeleteOpts{
+				RootOptions: &cmd.GlobalOptions{TeamId: teamId},
+			},
+			Prompt: mockPrompt,
+		}
+	})
+
+	It("deletes the vault after confirmation", func() {
+		mockPrompt.EXPECT().InputPrompt("Confirmation delete").Return("production")
+		mockClient.EXPECT().DeleteSharedVault(teamId, "production").Return(nil)
+
+		err := c.DeleteVault(mockClient, teamId, "production")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("does not delete the vault on wrong confirmation", func() {
+		mockPrompt.EXPECT().InputPrompt("Confirmation delete").Return("staging")
+
+		err := c.DeleteVault(mockClient, teamId, "production")
+		Expect(err).To(MatchError("confirmation failed"))
+	})
+
+	It("deletes single secrets without confirmation", func() {
+		mockClient.EXPECT().DeleteSharedSecrets(teamId, "production", []string{"DB_PASSWORD"}).Return(nil)
+
+		err := c.DeleteVaultSecrets(mockClient, teamId, "production", []string{"DB_PASSWORD"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
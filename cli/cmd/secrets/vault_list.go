@@ -0,0 +1,88 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type VaultListCmd struct {
+	cmd           *cobra.Command
+	Opts          VaultListOpts
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type VaultListOpts struct {
+	shared.RootOptions
+	OutputFormat shared.OutputFormat
+}
+
+func AddVaultListCmd(vault *cobra.Command, opts shared.RootOptions) {
+	l := VaultListCmd{
+		cmd: &cobra.Command{
+			Use:   "list [NAME]",
+			Short: "List shared vaults or their secret keys",
+			Long: io.Long(`List the shared vaults of a team.
+
+				When the name of a vault is given, the keys of all secrets stored in the vault are listed instead.`),
+			Args: cobra.MaximumNArgs(1),
+			Example: io.FormatExampleCommands("vault list", []io.Example{
+				{Cmd: "-t <team-id>", Desc: "List all shared vaults of a team"},
+				{Cmd: "-t <team-id> production", Desc: "List all secret keys of the production vault"},
+			}),
+		},
+		Opts:          VaultListOpts{RootOptions: opts},
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	addOutputFlag(l.cmd, &l.Opts.OutputFormat)
+	l.cmd.RunE = l.RunE
+	shared.AddCmd(vault, l.cmd)
+}
+
+func (c *VaultListCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	if len(args) == 1 {
+		return c.ListVaultSecrets(client, teamId, args[0])
+	}
+	return c.ListVaults(client, teamId)
+}
+
+func (c *VaultListCmd) ListVaults(client Client, teamId int) error {
+	vaults, err := client.ListSharedVaults(teamId)
+	if err != nil {
+		return fmt.Errorf("failed to list shared vaults: %w", err)
+	}
+
+	return io.PrintList(string(c.Opts.OutputFormat), vaults, func() table.Writer {
+		t := io.GetTableWriter()
+		t.AppendHeader(table.Row{"Name"})
+		for _, v := range vaults {
+			t.AppendRow(table.Row{v})
+		}
+		return t
+	})
+}
+
+func (c *VaultListCmd) ListVaultSecrets(client Client, teamId int, vaultName string) error {
+	keys, err := client.ListSharedSecrets(teamId, vaultName)
+	if err != nil {
+		return fmt.Errorf("failed to list secrets of shared vault %s: %w", vaultName, err)
+	}
+
+	return printKeys(keys, c.Opts.OutputFormat)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	secretscmd "github.com/codesphere-cloud/cs-go/cli/cmd/secrets"
+)
+
+var _ = Describe("VaultList", func() {
+	var (
+		mockClient *secretscmd.MockClient
+		c          *secretscmd.VaultListCmd
+		teamId     int
+	)
+
+	BeforeEach(func() {
+		mockClient = secretscmd.NewMockClient(GinkgoT())
+		teamId = 42
+		c = &secretscmd.VaultListCmd{
+			Opts: secretscmd.VaultListOpts{
+				RootOptions: &cmd.GlobalOptions{TeamId: teamId},
+			},
+		}
+	})
+
+	It("lists the vaults in a list output format", func() {
+		c.Opts.OutputFormat = "name"
+		mockClient.EXPECT().ListSharedVaults(teamId).Return([]string{"production"}, nil)
+
+		err := c.ListVaults(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("lists the secrets of a vault in a list output format", func() {
+		c.Opts.OutputFormat = "jsonpath={.items[0]}"
+		mockClient.EXPECT().ListSharedSecrets(teamId, "production").Return([]string{"DB_PASSWORD"}, nil)
+
+		err := c.ListVaultSecrets(mockClient, teamId, "production")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails for invalid output formats", func() {
+		c.Opts.OutputFormat = "xml"
+		mockClient.EXPECT().ListSharedVaults(teamId).Return([]string{"production"}, nil)
+
+		err := c.ListVaults(mockClient, teamId)
+		Expect(err).To(MatchError(ContainSubstring("invalid output format: xml")))
+	})
+})
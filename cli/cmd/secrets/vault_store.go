@@ -0,0 +1,82 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type VaultStoreCmd struct {
+	cmd           *cobra.Command
+	Opts          VaultStoreOpts
+	Source        SecretSource
+	ClientFactory func(shared.RootOptions) (Client, error)
+}
+
+type VaultStoreOpts struct {
+	shared.RootOptions
+	FromFiles []string
+}
+
+func AddVaultStoreCmd(vault *cobra.Command, opts shared.RootOptions) {
+	s := VaultStoreCmd{
+		cmd: &cobra.Command{
+			Use:   "store NAME [KEY]",
+			Short: "Store secrets in a shared vault",
+			Long: io.Long(`Store secrets in a shared vault, existing secrets with the same key are overwritten.
+
+				The value of KEY is read from stdin. Use --from-file to read values from files.`),
+			Args: cobra.RangeArgs(1, 2),
+			Example: io.FormatExampleCommands("vault store", []io.Example{
+				{Cmd: "-t <team-id> production DB_PASSWORD < password.txt", Desc: "Store a secret read from stdin"},
+				{Cmd: "-t <team-id> production --from-file TLS_KEY=./tls.key", Desc: "Store a secret read from a file"},
+			}),
+		},
+		Opts:          VaultStoreOpts{RootOptions: opts},
+		Source:        NewSecretSource(),
+		ClientFactory: func(opts shared.RootOptions) (Client, error) { return opts.NewClient() },
+	}
+	s.cmd.Flags().StringArrayVar(&s.Opts.FromFiles, "from-file", []string{}, "Secret in form KEY=path to read the value from a file, can be specified multiple times")
+	s.cmd.RunE = s.RunE
+	shared.AddCmd(vault, s.cmd)
+}
+
+func (c *VaultStoreCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	stdinKey := ""
+	if len(args) > 1 {
+		stdinKey = args[1]
+	}
+	secrets, err := c.Source.ReadSecrets(stdinKey, c.Opts.FromFiles)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.ClientFactory(c.Opts.RootOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.StoreSecrets(client, teamId, args[0], secrets)
+}
+
+func (c *VaultStoreCmd) StoreSecrets(client Client, teamId int, vaultName string, secrets map[string]string) error {
+	err := client.StoreSharedSecrets(teamId, vaultName, secrets)
+	if err != nil {
+		return fmt.Errorf("failed to store secrets in shared vault %s: %w", vaultName, err)
+	}
+
+	log.Printf("Stored secrets %s in shared vault %s\n", strings.Join(sortedKeys(secrets), ", "), vaultName)
+	return nil
+}
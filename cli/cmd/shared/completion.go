@@ -0,0 +1,266 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+)
+
+// PipelineStages are the stages of a workspace pipeline, in execution order.
+var PipelineStages = []string{"prepare", "test", "run"}
+
+// CompletionTimeout limits how long completions wait for the API so the shell never hangs.
+var CompletionTimeout = 3 * time.Second
+
+// completionCacheTTL is how long completion candidates fetched from the API are cached on disk.
+const completionCacheTTL = 30 * time.Second
+
+// CompletionClient is the part of the Codesphere client used to list completion candidates.
+type CompletionClient interface {
+	ListTeams(orgId string) ([]api.Team, error)
+	ListWorkspaces(teamId int) ([]api.Workspace, error)
+	ListWorkspacePlans() ([]api.WorkspacePlan, error)
+	ListBaseimages() ([]api.Baseimage, error)
+}
+
+// CompletionLister lists completion candidates via the API.
+type CompletionLister func(client CompletionClient) ([]cobra.Completion, error)
+
+// CompleteTeams completes team IDs, described by the team name.
+func CompleteTeams(opts RootOptions) cobra.CompletionFunc {
+	orgId := func() string {
+		orgId, _ := opts.GetOrgId()
+		return orgId
+	}
+	return completeFromApi(opts, func() string { return "teams|" + orgId() }, func(client CompletionClient) ([]cobra.Completion, error) {
+		teams, err := client.ListTeams(orgId())
+		if err != nil {
+			return nil, err
+		}
+		res := make([]cobra.Completion, len(teams))
+		for i, t := range teams {
+			res[i] = cobra.CompletionWithDesc(strconv.Itoa(t.Id), t.Name)
+		}
+		return res, nil
+	})
+}
+
+// CompleteWorkspaces completes workspace IDs, described by the workspace name.
+// Only workspaces of the selected team are completed, or of all teams if no team is selected.
+func CompleteWorkspaces(opts RootOptions) cobra.CompletionFunc {
+	teamId := func() int {
+		teamId, err := opts.GetTeamId()
+		if err != nil {
+			return -1
+		}
+		return teamId
+	}
+	key := func() string {
+		orgId, _ := opts.GetOrgId()
+		return fmt.Sprintf("workspaces|%s|%d", orgId, teamId())
+	}
+	return completeFromApi(opts, key, func(client CompletionClient) ([]cobra.Completion, error) {
+		teamIds := []int{teamId()}
+		if teamIds[0] < 0 {
+			orgId, err := opts.GetOrgId()
+			if err != nil {
+				return nil, err
+			}
+			teams, err := client.ListTeams(orgId)
+			if err != nil {
+				return nil, err
+			}
+			teamIds = make([]int, len(teams))
+			for i, t := range teams {
+				teamIds[i] = t.Id
+			}
+		}
+
+		res := []cobra.Completion{}
+		for _, id := range teamIds {
+			workspaces, err := client.ListWorkspaces(id)
+			if err != nil {
+				return nil, err
+			}
+			for _, w := range workspaces {
+				res = append(res, cobra.CompletionWithDesc(strconv.Itoa(w.Id), w.Name))
+			}
+		}
+		return res, nil
+	})
+}
+
+// CompletePlans completes workspace plan IDs, described by the plan title.
+func CompletePlans(opts RootOptions) cobra.CompletionFunc {
+	return completeFromApi(opts, func() string { return "plans" }, func(client CompletionClient) ([]cobra.Completion, error) {
+		plans, err := client.ListWorkspacePlans()
+		if err != nil {
+			return nil, err
+		}
+		res := []cobra.Completion{}
+		for _, p := range plans {
+			if p.Deprecated {
+				continue
+			}
+			res = append(res, cobra.CompletionWithDesc(strconv.Itoa(p.Id), p.Title))
+		}
+		return res, nil
+	})
+}
+
+// CompleteBaseimages completes base image IDs, described by the base image name.
+func CompleteBaseimages(opts RootOptions) cobra.CompletionFunc {
+	return completeFromApi(opts, func() string { return "baseimages" }, func(client CompletionClient) ([]cobra.Completion, error) {
+		baseimages, err := client.ListBaseimages()
+		if err != nil {
+			return nil, err
+		}
+		res := make([]cobra.Completion, len(baseimages))
+		for i, b := range baseimages {
+			res[i] = cobra.CompletionWithDesc(b.Id, b.Name)
+		}
+		return res, nil
+	})
+}
+
+// CompleteProfiles completes CI profiles from ci.<profile>.yml files in the working directory.
+func CompleteProfiles(_ *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	files, err := filepath.Glob("ci.*.yml")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	res := []cobra.Completion{}
+	for _, f := range files {
+		res = append(res, strings.TrimSuffix(strings.TrimPrefix(f, "ci."), ".yml"))
+	}
+	return res, cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteStages completes pipeline stages which are not given as arguments yet.
+func CompleteStages(_ *cobra.Command, args []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	res := []cobra.Completion{}
+	for _, stage := range PipelineStages {
+		if !slices.Contains(args, stage) {
+			res = append(res, stage)
+		}
+	}
+	return res, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeFromApi returns a completion function for candidates listed via the API.
+func completeFromApi(opts RootOptions, key func() string, list CompletionLister) cobra.CompletionFunc {
+	c := ApiCompletion{
+		Opts:          opts,
+		ClientFactory: func(opts RootOptions) (CompletionClient, error) { return opts.NewClient() },
+		Key:           key,
+		List:          list,
+	}
+	return c.Complete
+}
+
+// ApiCompletion completes candidates listed via the API.
+// Candidates are cached on disk per API URL and key for a short time, listing is aborted after CompletionTimeout.
+type ApiCompletion struct {
+	Opts          RootOptions
+	ClientFactory func(RootOptions) (CompletionClient, error)
+	// Key identifies the candidates in the cache
+	Key  func() string
+	List CompletionLister
+	// TTL is how long candidates are cached, defaults to 30 seconds
+	TTL time.Duration
+}
+
+func (c *ApiCompletion) Complete(_ *cobra.Command, _ []string, _ string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	type result struct {
+		candidates []cobra.Completion
+		err        error
+	}
+	done := make(chan result, 1)
+	go func() {
+		candidates, err := c.cachedCompletions(c.Opts.GetApiUrl() + "|" + c.Key())
+		done <- result{candidates, err}
+	}()
+
+	select {
+	case r := <-done:
+		if r.err != nil {
+			cobra.CompErrorln(r.err.Error())
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return r.candidates, cobra.ShellCompDirectiveNoFileComp
+	case <-time.After(CompletionTimeout):
+		cobra.CompErrorln(cserrors.TimedOut("listing completions", CompletionTimeout).Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+type completionCacheEntry struct {
+	Candidates []cobra.Completion `json:"candidates"`
+	Expires    time.Time          `json:"expires"`
+}
+
+func completionCachePath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "cs", "completions.json"), nil
+}
+
+// cachedCompletions returns the cached candidates for key if not expired, otherwise lists and caches them.
+func (c *ApiCompletion) cachedCompletions(key string) ([]cobra.Completion, error) {
+	cache := map[string]completionCacheEntry{}
+	path, pathErr := completionCachePath()
+	if pathErr == nil {
+		if data, err := os.ReadFile(path); err == nil {
+			_ = json.Unmarshal(data, &cache)
+		}
+	}
+
+	if entry, ok := cache[key]; ok && time.Now().Before(entry.Expires) {
+		return entry.Candidates, nil
+	}
+
+	client, err := c.ClientFactory(c.Opts)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := c.List(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if pathErr != nil {
+		return candidates, nil
+	}
+	now := time.Now()
+	for k, entry := range cache {
+		if now.After(entry.Expires) {
+			delete(cache, k)
+		}
+	}
+	ttl := c.TTL
+	if ttl == 0 {
+		ttl = completionCacheTTL
+	}
+	cache[key] = completionCacheEntry{Candidates: candidates, Expires: now.Add(ttl)}
+	if data, err := json.Marshal(cache); err == nil {
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err == nil {
+			_ = os.WriteFile(path, data, 0600)
+		}
+	}
+	return candidates, nil
+}
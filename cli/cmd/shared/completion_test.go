@@ -0,0 +1,110 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package shared_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("Completion", func() {
+	It("completes pipeline stages not given yet", func() {
+		completions, directive := shared.CompleteStages(nil, []string{"prepare"}, "")
+		Expect(completions).To(Equal([]cobra.Completion{"test", "run"}))
+		Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+	})
+
+	It("completes CI profiles in the working directory", func() {
+		dir := GinkgoT().TempDir()
+		for _, f := range []string{"ci.yml", "ci.prod.yml", "ci.dev.yml", "README.md"} {
+			Expect(os.WriteFile(filepath.Join(dir, f), []byte{}, 0644)).To(Succeed())
+		}
+		GinkgoT().Chdir(dir)
+
+		completions, directive := shared.CompleteProfiles(nil, nil, "")
+		Expect(completions).To(ConsistOf(cobra.Completion("dev"), cobra.Completion("prod")))
+		Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+	})
+
+	Context("candidates listed via the API", func() {
+		var (
+			mockClient *cmd.MockClient
+			listed     int
+			c          shared.ApiCompletion
+		)
+
+		BeforeEach(func() {
+			GinkgoT().Setenv("XDG_CACHE_HOME", GinkgoT().TempDir())
+			mockClient = cmd.NewMockClient(GinkgoT())
+			listed = 0
+			c = shared.ApiCompletion{
+				Opts: &cmd.GlobalOptions{ApiUrl: "https://codesphere.com/api"},
+				ClientFactory: func(shared.RootOptions) (shared.CompletionClient, error) {
+					return mockClient, nil
+				},
+				Key: func() string { return "teams" },
+				List: func(client shared.CompletionClient) ([]cobra.Completion, error) {
+					listed++
+					teams, err := client.ListTeams("")
+					if err != nil {
+						return nil, err
+					}
+					return []cobra.Completion{cobra.CompletionWithDesc("1", teams[0].Name)}, nil
+				},
+			}
+		})
+
+		It("lists the candidates", func() {
+			mockClient.EXPECT().ListTeams("").Return([]api.Team{{Id: 1, Name: "my-team"}}, nil).Once()
+
+			completions, directive := c.Complete(nil, nil, "")
+			Expect(completions).To(Equal([]cobra.Completion{"1\tmy-team"}))
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+		})
+
+		It("returns cached candidates without calling the API", func() {
+			mockClient.EXPECT().ListTeams("").Return([]api.Team{{Id: 1, Name: "my-team"}}, nil).Once()
+
+			first, _ := c.Complete(nil, nil, "")
+			second, _ := c.Complete(nil, nil, "")
+			Expect(second).To(Equal(first))
+			Expect(listed).To(Equal(1))
+		})
+
+		It("lists the candidates again when the cached ones expired", func() {
+			c.TTL = time.Millisecond
+			mockClient.EXPECT().ListTeams("").Return([]api.Team{{Id: 1, Name: "my-team"}}, nil).Twice()
+
+			c.Complete(nil, nil, "")
+			time.Sleep(10 * time.Millisecond)
+			c.Complete(nil, nil, "")
+			Expect(listed).To(Equal(2))
+		})
+
+		It("returns no candidates if listing takes longer than the completion timeout", func() {
+			timeout := shared.CompletionTimeout
+			shared.CompletionTimeout = 10 * time.Millisecond
+			DeferCleanup(func() { shared.CompletionTimeout = timeout })
+			unblock := make(chan struct{})
+			DeferCleanup(func() { close(unblock) })
+			c.List = func(shared.CompletionClient) ([]cobra.Completion, error) {
+				<-unblock
+				return nil, errors.New("aborted")
+			}
+
+			completions, directive := c.Complete(nil, nil, "")
+			Expect(completions).To(BeEmpty())
+			Expect(directive).To(Equal(cobra.ShellCompDirectiveNoFileComp))
+		})
+	})
+})
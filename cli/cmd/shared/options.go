@@ -4,6 +4,8 @@
 package shared
 
 import (
+	"errors"
+
 	"github.com/codesphere-cloud/cs-go/api"
 	"github.com/spf13/cobra"
 )
@@ -14,8 +16,13 @@ const (
 	OutputFormatTable OutputFormat = "table"
 	OutputFormatJSON  OutputFormat = "json"
 	OutputFormatYAML  OutputFormat = "yaml"
+	// OutputFormatDotEnv is only supported by commands listing environment variables
+	OutputFormatDotEnv OutputFormat = "dotenv"
 )
 
+// ErrTeamIdNotSet is returned by GetTeamId if the team is set neither via flag nor environment variable.
+var ErrTeamIdNotSet = errors.New("team ID not set, use -t or CS_TEAM_ID to set it")
+
 type RootOptions interface {
 	GetTeamId() (int, error)
 	GetOrgId() (string, error)
@@ -34,3 +41,15 @@ func AddCmd(parent *cobra.Command, cmd *cobra.Command) {
 	}
 	parent.AddCommand(cmd)
 }
+
+// RequireOrgId returns the organization ID and fails if it is set neither via flag nor environment variable.
+func RequireOrgId(opts RootOptions) (string, error) {
+	orgId, err := opts.GetOrgId()
+	if err != nil {
+		return "", err
+	}
+	if orgId == "" {
+		return "", errors.New("organization ID not set, use the -O/--org flag or the CS_ORG_ID environment variable")
+	}
+	return orgId, nil
+}
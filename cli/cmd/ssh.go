@@ -0,0 +1,25 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type SshCmd struct {
+	cmd *cobra.Command
+}
+
+func AddSshCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	ssh := SshCmd{
+		cmd: &cobra.Command{
+			Use:   "ssh",
+			Short: "SSH access to workspaces",
+			Long:  `Helpers to set up SSH access to Codesphere workspaces. Use 'add ssh-key' to upload your public key first.`,
+		},
+	}
+	rootCmd.AddCommand(ssh.cmd)
+
+	AddSshConfigCmd(ssh.cmd, opts)
+}
@@ -0,0 +1,176 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type SshConfigCmd struct {
+	cmd  *cobra.Command
+	Opts SshConfigOpts
+}
+
+type SshConfigOpts struct {
+	*GlobalOptions
+	Alias        string
+	HostName     string
+	Port         int
+	User         string
+	IdentityFile string
+	ConfigFile   string
+	Print        bool
+}
+
+func (c *SshConfigCmd) RunE(_ *cobra.Command, args []string) error {
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.WriteSshConfig(client, wsId)
+}
+
+func AddSshConfigCmd(ssh *cobra.Command, opts *GlobalOptions) {
+	config := SshConfigCmd{
+		cmd: &cobra.Command{
+			Use:   "config",
+			Short: "Add SSH config for a workspace",
+			Long: io.Long(`Write a Host block for a workspace into your SSH config, so you can connect with 'ssh <alias>'.
+
+				The block is replaced when the command is run again for the same workspace.
+				By default the host of the Codesphere API is used as SSH host and the workspace ID as user.`),
+			Example: io.FormatExampleCommands("ssh config", []io.Example{
+				{Cmd: "-w 1234", Desc: "Add Host block 'cs-<workspace-name>' to ~/.ssh/config"},
+				{Cmd: "-w 1234 --alias my-ws --identity-file ~/.ssh/id_ed25519", Desc: "Add Host block with custom alias and key"},
+				{Cmd: "-w 1234 --print", Desc: "Print the Host block instead of writing it"},
+			}),
+		},
+		Opts: SshConfigOpts{GlobalOptions: opts},
+	}
+	config.cmd.Flags().StringVar(&config.Opts.Alias, "alias", "", "Host alias to use with ssh (default: cs-<workspace-name>)")
+	config.cmd.Flags().StringVar(&config.Opts.HostName, "hostname", "", "SSH host (default: host of the Codesphere API)")
+	config.cmd.Flags().IntVar(&config.Opts.Port, "port", 22, "SSH port")
+	config.cmd.Flags().StringVar(&config.Opts.User, "user", "", "SSH user (default: workspace ID)")
+	config.cmd.Flags().StringVar(&config.Opts.IdentityFile, "identity-file", "", "Private key to use for the connection")
+	config.cmd.Flags().StringVar(&config.Opts.ConfigFile, "config-file", "", "SSH config file to write to (default: ~/.ssh/config)")
+	config.cmd.Flags().BoolVar(&config.Opts.Print, "print", false, "Print the Host block to stdout instead of writing the config file")
+	config.cmd.RunE = config.RunE
+	ssh.AddCommand(config.cmd)
+}
+
+func (c *SshConfigCmd) WriteSshConfig(client Client, wsId int) error {
+	workspace, err := client.GetWorkspace(wsId)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	block, err := c.HostBlock(workspace.Id, workspace.Name)
+	if err != nil {
+		return err
+	}
+
+	if c.Opts.Print {
+		fmt.Print(block)
+		return nil
+	}
+	if c.Opts.ConfigFile == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return errors.New("failed to determine home directory, specify the SSH config file with --config-file")
+		}
+		c.Opts.ConfigFile = filepath.Join(home, ".ssh", "config")
+	}
+
+	existing, err := os.ReadFile(c.Opts.ConfigFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read SSH config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Opts.ConfigFile), 0700); err != nil {
+		return fmt.Errorf("failed to create SSH config directory: %w", err)
+	}
+	updated := UpsertSshConfigBlock(string(existing), wsId, block)
+	if err := os.WriteFile(c.Opts.ConfigFile, []byte(updated), 0600); err != nil {
+		return fmt.Errorf("failed to write SSH config: %w", err)
+	}
+
+	log.Printf("Added Host %s to %s, connect with 'ssh %s'\n", c.alias(workspace.Name), c.Opts.ConfigFile, c.alias(workspace.Name))
+	return nil
+}
+
+// HostBlock renders the SSH config block of a workspace, enclosed in markers to find it again.
+func (c *SshConfigCmd) HostBlock(wsId int, wsName string) (string, error) {
+	hostName := c.Opts.HostName
+	if hostName == "" {
+		apiUrl, err := url.Parse(c.Opts.GetApiUrl())
+		if err != nil || apiUrl.Hostname() == "" {
+			return "", fmt.Errorf("failed to determine SSH host from API URL '%s', set it with --hostname", c.Opts.GetApiUrl())
+		}
+		hostName = apiUrl.Hostname()
+	}
+	user := c.Opts.User
+	if user == "" {
+		user = fmt.Sprintf("%d", wsId)
+	}
+
+	b := strings.Builder{}
+	b.WriteString(sshConfigBeginMarker(wsId) + "\n")
+	b.WriteString(fmt.Sprintf("Host %s\n", c.alias(wsName)))
+	b.WriteString(fmt.Sprintf("    HostName %s\n", hostName))
+	b.WriteString(fmt.Sprintf("    Port %d\n", c.Opts.Port))
+	b.WriteString(fmt.Sprintf("    User %s\n", user))
+	if c.Opts.IdentityFile != "" {
+		b.WriteString(fmt.Sprintf("    IdentityFile %s\n", c.Opts.IdentityFile))
+		b.WriteString("    IdentitiesOnly yes\n")
+	}
+	b.WriteString(sshConfigEndMarker(wsId) + "\n")
+	return b.String(), nil
+}
+
+func (c *SshConfigCmd) alias(wsName string) string {
+	if c.Opts.Alias != "" {
+		return c.Opts.Alias
+	}
+	return "cs-" + regexp.MustCompile(`[^a-zA-Z0-9._-]+`).ReplaceAllString(wsName, "-")
+}
+
+// UpsertSshConfigBlock replaces the block of the workspace in the SSH config or appends it if not present.
+func UpsertSshConfigBlock(config string, wsId int, block string) string {
+	begin := strings.Index(config, sshConfigBeginMarker(wsId)+"\n")
+	end := strings.Index(config, sshConfigEndMarker(wsId)+"\n")
+	if begin >= 0 && end > begin {
+		return config[:begin] + block + config[end+len(sshConfigEndMarker(wsId))+1:]
+	}
+
+	if config != "" && !strings.HasSuffix(config, "\n") {
+		config += "\n"
+	}
+	if config != "" {
+		config += "\n"
+	}
+	return config + block
+}
+
+func sshConfigBeginMarker(wsId int) string {
+	return fmt.Sprintf("# BEGIN Codesphere workspace %d", wsId)
+}
+
+func sshConfigEndMarker(wsId int) string {
+	return fmt.Sprintf("# END Codesphere workspace %d", wsId)
+}
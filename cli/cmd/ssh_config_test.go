@@ -0,0 +1,86 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("SshConfig", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.SshConfigCmd
+		configFile string
+		wsId       int
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		configFile = filepath.Join(GinkgoT().TempDir(), ".ssh", "config")
+		wsId = 1234
+		c = &cmd.SshConfigCmd{
+			Opts: cmd.SshConfigOpts{
+				GlobalOptions: &cmd.GlobalOptions{ApiUrl: "https://codesphere.com/api"},
+				Port:          22,
+				ConfigFile:    configFile,
+			},
+		}
+	})
+
+	It("writes a host block derived from the workspace", func() {
+		mockClient.EXPECT().GetWorkspace(wsId).Return(api.Workspace{Id: wsId, Name: "my ws"}, nil)
+
+		err := c.WriteSshConfig(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+
+		content, err := os.ReadFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal(`# BEGIN Codesphere workspace 1234
+Host cs-my-ws
+    HostName codesphere.com
+    Port 22
+    User 1234
+# END Codesphere workspace 1234
+`))
+	})
+
+	It("replaces the block of the workspace and keeps other entries", func() {
+		Expect(os.MkdirAll(filepath.Dir(configFile), 0700)).To(Succeed())
+		Expect(os.WriteFile(configFile, []byte("Host github.com\n    User git\n\n# BEGIN Codesphere workspace 1234\nHost old\n# END Codesphere workspace 1234\nHost other\n"), 0600)).To(Succeed())
+		c.Opts.Alias = "dev"
+		c.Opts.IdentityFile = "~/.ssh/id_ed25519"
+		mockClient.EXPECT().GetWorkspace(wsId).Return(api.Workspace{Id: wsId, Name: "my-ws"}, nil)
+
+		err := c.WriteSshConfig(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+
+		content, err := os.ReadFile(configFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal(`Host github.com
+    User git
+
+# BEGIN Codesphere workspace 1234
+Host dev
+    HostName codesphere.com
+    Port 22
+    User 1234
+    IdentityFile ~/.ssh/id_ed25519
+    IdentitiesOnly yes
+# END Codesphere workspace 1234
+Host other
+`))
+	})
+
+	It("appends the block to an existing config", func() {
+		updated := cmd.UpsertSshConfigBlock("Host github.com\n    User git", 1, "# BEGIN Codesphere workspace 1\n# END Codesphere workspace 1\n")
+		Expect(updated).To(Equal("Host github.com\n    User git\n\n# BEGIN Codesphere workspace 1\n# END Codesphere workspace 1\n"))
+	})
+})
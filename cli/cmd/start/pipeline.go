@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
 	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	"github.com/codesphere-cloud/cs-go/pkg/io"
 
@@ -60,9 +61,10 @@ func (c *StartPipelineCmd) RunE(_ *cobra.Command, args []string) error {
 func AddStartPipelineCmd(start *cobra.Command, opts shared.RootOptions) {
 	pipeline := StartPipelineCmd{
 		cmd: &cobra.Command{
-			Use:   "pipeline",
-			Short: "Start pipeline stages of a workspace",
-			Args:  cobra.RangeArgs(1, 3),
+			Use:               "pipeline",
+			Short:             "Start pipeline stages of a workspace",
+			Args:              cobra.RangeArgs(1, 3),
+			ValidArgsFunction: shared.CompleteStages,
 			Long: io.Long(`Start one or many pipeline stages of a workspace.
 
 				Stages can be 'prepare', 'test', or 'run'.
@@ -93,6 +95,7 @@ func AddStartPipelineCmd(start *cobra.Command, opts shared.RootOptions) {
 
 	pipeline.Opts.Timeout = pipeline.cmd.Flags().Duration("timeout", 30*time.Minute, "Time to wait per stage before stopping the command execution (e.g. 10m)")
 	pipeline.Opts.Profile = pipeline.cmd.Flags().StringP("profile", "p", "", "CI profile to use (e.g. 'prod' for the profile defined in 'ci.prod.yml'), defaults to the ci.yml profile")
+	_ = pipeline.cmd.RegisterFlagCompletionFunc("profile", shared.CompleteProfiles)
 	shared.AddCmd(start, pipeline.cmd)
 
 	pipeline.cmd.RunE = pipeline.RunE
@@ -114,7 +117,7 @@ func (c *StartPipelineCmd) StartPipelineStages(client Client, wsId int, stages [
 }
 
 func isValidStage(stage string) bool {
-	return slices.Contains([]string{"prepare", "test", "run"}, stage)
+	return slices.Contains(shared.PipelineStages, stage)
 }
 
 func (c *StartPipelineCmd) startStage(client Client, wsId int, stage string) error {
@@ -159,13 +162,13 @@ func (c *StartPipelineCmd) waitForPipelineStage(client Client, wsId int, stage s
 		err = shouldAbort(status)
 		if err != nil {
 			log.Println("(failed)")
-			return fmt.Errorf("stage %s failed: %w", stage, err)
+			return &StageFailedError{Stage: stage, Err: err}
 		}
 
 		log.Print(".")
 		if c.Time.Now().After(maxWaitTime) {
 			log.Println()
-			return fmt.Errorf("timed out waiting for pipeline stage %s to be complete", stage)
+			return fmt.Errorf("%w waiting for pipeline stage %s to be complete", cserrors.ErrTimedOut, stage)
 		}
 		c.Time.Sleep(delay)
 	}
@@ -196,6 +199,20 @@ func (c *StartPipelineCmd) allFinished(status []api.PipelineStatus) bool {
 	return true
 }
 
+// StageFailedError is returned if a server of a pipeline stage failed or was aborted.
+type StageFailedError struct {
+	Stage string
+	Err   error
+}
+
+func (e *StageFailedError) Error() string {
+	return fmt.Sprintf("stage %s failed: %s", e.Stage, e.Err)
+}
+
+func (e *StageFailedError) Unwrap() error {
+	return e.Err
+}
+
 func shouldAbort(status []api.PipelineStatus) error {
 	for _, s := range status {
 		if slices.Contains([]string{"failure", "aborted"}, s.State) {
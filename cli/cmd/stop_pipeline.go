@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"log"
 	"slices"
+	"time"
 
+	"github.com/codesphere-cloud/cs-go/api"
 	"github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	"github.com/codesphere-cloud/cs-go/pkg/io"
 	"github.com/spf13/cobra"
@@ -16,10 +18,14 @@ import (
 type StopPipelineCmd struct {
 	cmd  *cobra.Command
 	Opts StopPipelineOpts
+	Time api.Time
 }
 
 type StopPipelineOpts struct {
 	*GlobalOptions
+
+	Teardown bool
+	Timeout  time.Duration
 }
 
 func (c *StopPipelineCmd) RunE(_ *cobra.Command, args []string) error {
@@ -39,22 +45,28 @@ func (c *StopPipelineCmd) RunE(_ *cobra.Command, args []string) error {
 func AddStopPipelineCmd(stop *cobra.Command, opts *GlobalOptions) {
 	pipeline := StopPipelineCmd{
 		cmd: &cobra.Command{
-			Use:   "pipeline",
-			Short: "Stop pipeline stages of a workspace",
-			Args:  cobra.RangeArgs(1, 3),
+			Use:               "pipeline",
+			Short:             "Stop pipeline stages of a workspace",
+			Args:              cobra.RangeArgs(1, 3),
+			ValidArgsFunction: shared.CompleteStages,
 			Long: io.Long(`Stop one or many pipeline stages of a workspace.
 
 				Stages can be 'prepare', 'test', or 'run'.
 				When multiple stages are specified, the command will stop them in the provided order.
-				The command sends a stop request for each stage and returns after all requests succeed.`),
+				The command sends a stop request for each stage and returns after all requests succeed.
+				With --teardown the landscape is torn down afterwards and the command waits until all services of the run stage are gone.`),
 			Example: io.FormatExampleCommands("stop pipeline", []io.Example{
 				{Cmd: "run", Desc: "Stop the run stage"},
 				{Cmd: "prepare test", Desc: "Stop the prepare and test stages in order"},
 				{Cmd: "prepare test run", Desc: "Stop the prepare, test, and run stages in order"},
+				{Cmd: "run --teardown", Desc: "Stop the run stage and tear down the landscape to free its resources"},
 			}),
 		},
 		Opts: StopPipelineOpts{GlobalOptions: opts},
+		Time: &api.RealTime{},
 	}
+	pipeline.cmd.Flags().BoolVar(&pipeline.Opts.Teardown, "teardown", false, "Tear down the landscape after stopping the stages")
+	pipeline.cmd.Flags().DurationVar(&pipeline.Opts.Timeout, "timeout", 10*time.Minute, "Time to wait for the landscape to be torn down (e.g. 5m)")
 	shared.AddCmd(stop, pipeline.cmd)
 	pipeline.cmd.RunE = pipeline.RunE
 }
@@ -71,6 +83,20 @@ func (c *StopPipelineCmd) StopPipelineStages(client Client, wsId int, stages []s
 			return err
 		}
 	}
+
+	if !c.Opts.Teardown {
+		return nil
+	}
+	log.Printf("tearing down landscape of workspace %d...\n", wsId)
+	err := client.TeardownLandscape(wsId)
+	if err != nil {
+		return fmt.Errorf("failed to tear down landscape: %w", err)
+	}
+	err = waitForLandscapeTeardown(client, c.Time, wsId, c.Opts.Timeout)
+	if err != nil {
+		return err
+	}
+	log.Printf("landscape of workspace %d torn down\n", wsId)
 	return nil
 }
 
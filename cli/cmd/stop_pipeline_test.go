@@ -4,9 +4,12 @@
 package cmd_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/codesphere-cloud/cs-go/api"
 	"github.com/codesphere-cloud/cs-go/cli/cmd"
 )
 
@@ -51,5 +54,22 @@ var _ = Describe("StopPipeline", func() {
 			err := c.StopPipelineStages(mockClient, wsId, stages)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("tears down the landscape after stopping with --teardown", func() {
+			c.Opts.Teardown = true
+			c.Opts.Timeout = time.Minute
+			mockTime := api.NewMockTime(GinkgoT())
+			mockTime.EXPECT().Now().Return(time.Now())
+			c.Time = mockTime
+
+			mockClient.EXPECT().StopPipelineStage(wsId, stages[0]).Return(nil)
+			mockClient.EXPECT().StopPipelineStage(wsId, stages[1]).Return(nil)
+			mockClient.EXPECT().StopPipelineStage(wsId, stages[2]).Return(nil)
+			mockClient.EXPECT().TeardownLandscape(wsId).Return(nil)
+			mockClient.EXPECT().GetPipelineState(wsId, "run").Return([]api.PipelineStatus{}, nil)
+
+			err := c.StopPipelineStages(mockClient, wsId, stages)
+			Expect(err).NotTo(HaveOccurred())
+		})
 	})
 })
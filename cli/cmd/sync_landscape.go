@@ -49,6 +49,7 @@ func AddSyncLandscapeCmd(sync *cobra.Command, opts *GlobalOptions) {
 	}
 
 	workspace.cmd.Flags().StringVarP(&workspace.Opts.Profile, "profile", "p", "", "CI profile to use (e.g. 'prod' for the profile defined in 'ci.prod.yml'), defaults to the ci.yml profile")
+	_ = workspace.cmd.RegisterFlagCompletionFunc("profile", shared.CompleteProfiles)
 
 	workspace.cmd.RunE = workspace.RunE
 
@@ -0,0 +1,28 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type TeardownCmd struct {
+	cmd *cobra.Command
+}
+
+func AddTeardownCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	teardown := TeardownCmd{
+		cmd: &cobra.Command{
+			Use:   "teardown",
+			Short: "Tear down Codesphere resources",
+			Long:  io.Long(`Tear down Codesphere resources, like infrastructure allocated to run services.`),
+		},
+	}
+	shared.AddCmd(rootCmd, teardown.cmd)
+
+	AddTeardownLandscapeCmd(teardown.cmd, opts)
+}
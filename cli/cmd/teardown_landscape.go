@@ -0,0 +1,123 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	startcmd "github.com/codesphere-cloud/cs-go/cli/cmd/start"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type TeardownLandscapeCmd struct {
+	cmd  *cobra.Command
+	Opts TeardownLandscapeOpts
+	Time api.Time
+}
+
+type TeardownLandscapeOpts struct {
+	*GlobalOptions
+
+	Timeout time.Duration
+	NoWait  bool
+}
+
+func (c *TeardownLandscapeCmd) RunE(_ *cobra.Command, args []string) error {
+	workspaceId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.TeardownLandscape(client, workspaceId)
+}
+
+func AddTeardownLandscapeCmd(teardown *cobra.Command, opts *GlobalOptions) {
+	landscape := TeardownLandscapeCmd{
+		cmd: &cobra.Command{
+			Use:   "landscape",
+			Short: "Tear down landscape",
+			Long: io.Long(`Tear down the landscape of a workspace, i.e. stop all services of the run stage and free their resources.
+
+				By default the command waits until all services of the run stage are gone.`),
+			Example: io.FormatExampleCommands("teardown landscape", []io.Example{
+				{Cmd: "-w <workspace-id>", Desc: "Tear down the landscape and wait until all services are gone"},
+				{Cmd: "-w <workspace-id> --timeout 2m", Desc: "Tear down the landscape, fail if services are still present after 2 minutes"},
+				{Cmd: "-w <workspace-id> --no-wait", Desc: "Request the teardown without waiting"},
+			}),
+		},
+		Opts: TeardownLandscapeOpts{GlobalOptions: opts},
+		Time: &api.RealTime{},
+	}
+
+	landscape.cmd.Flags().DurationVar(&landscape.Opts.Timeout, "timeout", 10*time.Minute, "Time to wait for the landscape to be torn down (e.g. 5m)")
+	landscape.cmd.Flags().BoolVar(&landscape.Opts.NoWait, "no-wait", false, "Don't wait for the landscape to be torn down")
+
+	landscape.cmd.RunE = landscape.RunE
+
+	shared.AddCmd(teardown, landscape.cmd)
+}
+
+func (c *TeardownLandscapeCmd) TeardownLandscape(client Client, wsId int) error {
+	err := client.TeardownLandscape(wsId)
+	if err != nil {
+		return fmt.Errorf("failed to tear down landscape: %w", err)
+	}
+
+	if c.Opts.NoWait {
+		log.Printf("Landscape teardown requested for workspace %d\n", wsId)
+		return nil
+	}
+
+	err = waitForLandscapeTeardown(client, c.Time, wsId, c.Opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Landscape torn down successfully for workspace %d\n", wsId)
+	return nil
+}
+
+// waitForLandscapeTeardown waits until no services of the run stage are left, ignoring the IDE server.
+//
+// Returns [cserrors.TimedOutError] if services are still present after the timeout.
+func waitForLandscapeTeardown(client Client, clock api.Time, wsId int, timeout time.Duration) error {
+	delay := 5 * time.Second
+
+	maxWaitTime := clock.Now().Add(timeout)
+	for {
+		status, err := client.GetPipelineState(wsId, "run")
+		if err != nil {
+			log.Printf("Error getting pipeline status: %s, trying again...\n", err.Error())
+		} else if remainingServices(status) == 0 {
+			return nil
+		}
+
+		if clock.Now().After(maxWaitTime) {
+			return cserrors.TimedOut(fmt.Sprintf("waiting for landscape of workspace %d to be torn down", wsId), timeout)
+		}
+		clock.Sleep(delay)
+	}
+}
+
+func remainingServices(status []api.PipelineStatus) int {
+	remaining := 0
+	for _, s := range status {
+		if s.Server != startcmd.IdeServer {
+			remaining++
+		}
+	}
+	return remaining
+}
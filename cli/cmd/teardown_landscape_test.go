@@ -0,0 +1,84 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("TeardownLandscape", func() {
+	var (
+		mockClient *cmd.MockClient
+		mockTime   *api.MockTime
+		c          *cmd.TeardownLandscapeCmd
+		wsId       int
+		now        time.Time
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		mockTime = api.NewMockTime(GinkgoT())
+		wsId = 42
+		now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		c = &cmd.TeardownLandscapeCmd{
+			Opts: cmd.TeardownLandscapeOpts{
+				GlobalOptions: &cmd.GlobalOptions{},
+				Timeout:       time.Minute,
+			},
+			Time: mockTime,
+		}
+		mockTime.EXPECT().Now().RunAndReturn(func() time.Time { return now }).Maybe()
+		mockTime.EXPECT().Sleep(mock.Anything).Run(func(d time.Duration) { now = now.Add(d) }).Maybe()
+	})
+
+	It("waits until all services of the run stage are gone", func() {
+		mockClient.EXPECT().TeardownLandscape(wsId).Return(nil)
+		mockClient.EXPECT().GetPipelineState(wsId, "run").Return([]api.PipelineStatus{
+			{Server: "web", State: "running"},
+			{Server: "codesphere-ide", State: "running"},
+		}, nil).Once()
+		mockClient.EXPECT().GetPipelineState(wsId, "run").Return(nil, errors.New("temporary")).Once()
+		mockClient.EXPECT().GetPipelineState(wsId, "run").Return([]api.PipelineStatus{
+			{Server: "codesphere-ide", State: "running"},
+		}, nil).Once()
+
+		err := c.TeardownLandscape(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns a timeout error if services remain", func() {
+		mockClient.EXPECT().TeardownLandscape(wsId).Return(nil)
+		mockClient.EXPECT().GetPipelineState(wsId, "run").Return([]api.PipelineStatus{
+			{Server: "web", State: "running"},
+		}, nil)
+
+		err := c.TeardownLandscape(mockClient, wsId)
+		var timedOut *cserrors.TimedOutError
+		Expect(errors.As(err, &timedOut)).To(BeTrue())
+	})
+
+	It("doesn't wait with --no-wait", func() {
+		c.Opts.NoWait = true
+		mockClient.EXPECT().TeardownLandscape(wsId).Return(nil)
+
+		err := c.TeardownLandscape(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails if the teardown request fails", func() {
+		mockClient.EXPECT().TeardownLandscape(wsId).Return(errors.New("forbidden"))
+
+		err := c.TeardownLandscape(mockClient, wsId)
+		Expect(err).To(MatchError("failed to tear down landscape: forbidden"))
+	})
+})
@@ -13,6 +13,7 @@ import (
 
 	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
 )
 
 type UpdateCmd struct {
@@ -24,16 +25,23 @@ func (c *UpdateCmd) RunE(_ *cobra.Command, args []string) error {
 	return SelfUpdate()
 }
 
-func AddUpdateCmd(rootCmd *cobra.Command) {
+func AddUpdateCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
 	update := UpdateCmd{
 		cmd: &cobra.Command{
 			Use:   "update",
-			Short: "Update Codesphere CLI",
-			Long:  `Updates the Codesphere CLI to the latest release from GitHub.`,
+			Short: "Update Codesphere CLI or resources",
+			Long: io.Long(`Updates the Codesphere CLI to the latest release from GitHub.
+
+				Use the subcommands to update Codesphere resources instead.`),
 		},
 	}
 	shared.AddCmd(rootCmd, update.cmd)
 	update.cmd.RunE = update.RunE
+
+	AddUpdateServiceCmd(update.cmd, opts)
+	AddUpdateWorkspaceCmd(update.cmd, opts)
+	AddUpdateOrgMemberCmd(update.cmd, opts)
+	AddUpdateTeamMemberCmd(update.cmd, opts)
 }
 
 func SelfUpdate() error {
@@ -0,0 +1,78 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type UpdateOrgMemberCmd struct {
+	cmd  *cobra.Command
+	Opts UpdateOrgMemberOpts
+}
+
+type UpdateOrgMemberOpts struct {
+	*GlobalOptions
+	UserId int
+	Role   string
+}
+
+func (c *UpdateOrgMemberCmd) RunE(_ *cobra.Command, args []string) error {
+	orgId, err := shared.RequireOrgId(c.Opts.GlobalOptions)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.UpdateOrgMember(client, orgId)
+}
+
+func AddUpdateOrgMemberCmd(update *cobra.Command, opts *GlobalOptions) {
+	member := UpdateOrgMemberCmd{
+		cmd: &cobra.Command{
+			Use:   "org-member",
+			Short: "Update organization member",
+			Long: io.Long(`Change the role of an organization member.
+
+				The organization is selected with the -O/--org flag or the CS_ORG_ID environment variable.`),
+			Example: io.FormatExampleCommands("update org-member", []io.Example{
+				{Cmd: "-O <org-id> -u <user-id> --role admin", Desc: "Promote an organization member to admin"},
+			}),
+		},
+		Opts: UpdateOrgMemberOpts{GlobalOptions: opts},
+	}
+	member.cmd.Flags().IntVarP(&member.Opts.UserId, "user", "u", 0, "Organization member user ID")
+	member.cmd.Flags().StringVarP(&member.Opts.Role, "role", "r", "", "New role of the organization member, e.g. member or admin")
+	_ = member.cmd.MarkFlagRequired("user")
+	_ = member.cmd.MarkFlagRequired("role")
+	member.cmd.RunE = member.RunE
+	update.AddCommand(member.cmd)
+}
+
+func (c *UpdateOrgMemberCmd) UpdateOrgMember(client Client, orgId string) error {
+	if c.Opts.UserId <= 0 {
+		return errors.New("user ID has to be set")
+	}
+	if c.Opts.Role == "" {
+		return errors.New("role cannot be empty")
+	}
+
+	err := client.ChangeOrgMemberRole(orgId, c.Opts.UserId, c.Opts.Role)
+	if err != nil {
+		return fmt.Errorf("failed to change role of organization member: %w", err)
+	}
+
+	log.Printf("Role of user %d in organization %s changed to %s\n", c.Opts.UserId, orgId, c.Opts.Role)
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("UpdateOrgMember", func() {
+	var (
+		mockEnv    *cmd.MockEnv
+		mockClient *cmd.MockClient
+		c          *cmd.UpdateOrgMemberCmd
+		orgId      string
+	)
+
+	BeforeEach(func() {
+		mockEnv = cmd.NewMockEnv(GinkgoT())
+		mockClient = cmd.NewMockClient(GinkgoT())
+		orgId = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+		c = &cmd.UpdateOrgMemberCmd{
+			Opts: cmd.UpdateOrgMemberOpts{
+				GlobalOptions: &cmd.GlobalOptions{Env: mockEnv},
+				UserId:        12,
+				Role:          "admin",
+			},
+		}
+	})
+
+	It("changes the role of the member", func() {
+		mockClient.EXPECT().ChangeOrgMemberRole(orgId, 12, "admin").Return(nil)
+
+		err := c.UpdateOrgMember(mockClient, orgId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns API errors", func() {
+		mockClient.EXPECT().ChangeOrgMemberRole(orgId, 12, "admin").Return(errors.New("forbidden"))
+
+		err := c.UpdateOrgMember(mockClient, orgId)
+		Expect(err).To(MatchError("failed to change role of organization member: forbidden"))
+	})
+
+	It("fails if the user ID is empty", func() {
+		c.Opts.UserId = 0
+
+		err := c.UpdateOrgMember(mockClient, orgId)
+		Expect(err).To(MatchError("user ID has to be set"))
+	})
+
+	It("fails on empty roles", func() {
+		c.Opts.Role = ""
+
+		err := c.UpdateOrgMember(mockClient, orgId)
+		Expect(err).To(MatchError("role cannot be empty"))
+	})
+
+	It("requires an organization ID", func() {
+		mockEnv.EXPECT().GetOrgId().Return("")
+
+		err := c.RunE(nil, []string{})
+		Expect(err).To(MatchError("organization ID not set, use the -O/--org flag or the CS_ORG_ID environment variable"))
+	})
+})
@@ -0,0 +1,141 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	createcmd "github.com/codesphere-cloud/cs-go/cli/cmd/create"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type UpdateServiceCmd struct {
+	cmd          *cobra.Command
+	Opts         UpdateServiceOpts
+	SecretSource createcmd.ServiceSecretSource
+}
+
+type UpdateServiceOpts struct {
+	*GlobalOptions
+	ServiceId      string
+	Name           *string // nil to keep the current name
+	PlanId         *int    // nil to keep the current plan
+	PlanParameters []string
+	Config         []string
+	Secrets        []string
+	Pause          *bool // nil to keep the current state
+}
+
+func (c *UpdateServiceCmd) RunE(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("name") {
+		name, _ := cmd.Flags().GetString("name")
+		c.Opts.Name = &name
+	}
+	if cmd.Flags().Changed("plan") {
+		planId, _ := cmd.Flags().GetInt("plan")
+		c.Opts.PlanId = &planId
+	}
+	if cmd.Flags().Changed("pause") {
+		pause, _ := cmd.Flags().GetBool("pause")
+		c.Opts.Pause = &pause
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.UpdateService(client)
+}
+
+func AddUpdateServiceCmd(update *cobra.Command, opts *GlobalOptions) {
+	service := UpdateServiceCmd{
+		cmd: &cobra.Command{
+			Use:   "service",
+			Short: "Update managed service",
+			Long: io.Long(`Update name, plan, config, secrets or paused state of a managed service.
+
+				Only the given values are changed, all other settings are kept.
+				Config values are parsed as JSON if possible (e.g. numbers and booleans), otherwise they are passed as string.
+				Secret values are always passed as string, use key=@path to read a secret from a file or key=- to read it from stdin.`),
+			Example: io.FormatExampleCommands("update service", []io.Example{
+				{Cmd: "--id <service-id> --name new-name", Desc: "Rename a managed service"},
+				{Cmd: "--id <service-id> --plan 2 --plan-param storage=20", Desc: "Change the plan of a managed service"},
+				{Cmd: "--id <service-id> --config max_connections=100", Desc: "Update the config of a managed service"},
+				{Cmd: "--id <service-id> --secret password=-", Desc: "Change a secret of a managed service, read from stdin"},
+				{Cmd: "--id <service-id> --pause", Desc: "Pause a managed service"},
+				{Cmd: "--id <service-id> --pause=false", Desc: "Resume a paused managed service"},
+			}),
+		},
+		Opts:         UpdateServiceOpts{GlobalOptions: opts},
+		SecretSource: createcmd.NewServiceSecretSource(),
+	}
+	service.cmd.Flags().StringVar(&service.Opts.ServiceId, "id", "", "ID of the managed service")
+	service.cmd.Flags().StringP("name", "n", "", "New name of the managed service")
+	service.cmd.Flags().Int("plan", -1, "New plan ID of the managed service")
+	service.cmd.Flags().StringArrayVar(&service.Opts.PlanParameters, "plan-param", []string{}, "Plan parameter in form key=value, can be specified multiple times (requires --plan)")
+	service.cmd.Flags().StringArrayVar(&service.Opts.Config, "config", []string{}, "Service config in form key=value, can be specified multiple times")
+	service.cmd.Flags().StringArrayVar(&service.Opts.Secrets, "secret", []string{}, "Service secret in form key=value, key=@path or key=- to read the value from a file or stdin, can be specified multiple times")
+	service.cmd.Flags().Bool("pause", false, "Pause (or resume with --pause=false) the managed service")
+	_ = service.cmd.MarkFlagRequired("id")
+	service.cmd.RunE = service.RunE
+	update.AddCommand(service.cmd)
+}
+
+func (c *UpdateServiceCmd) UpdateService(client Client) error {
+	args, err := c.buildUpdateArgs()
+	if err != nil {
+		return err
+	}
+
+	service, err := client.UpdateManagedService(c.Opts.ServiceId, args)
+	if err != nil {
+		return fmt.Errorf("failed to update managed service: %w", err)
+	}
+
+	log.Printf("Managed service '%s' (%s) updated\n", service.Name, service.Id)
+	return nil
+}
+
+func (c *UpdateServiceCmd) buildUpdateArgs() (api.UpdateManagedServiceArgs, error) {
+	args := api.UpdateManagedServiceArgs{
+		Name:  c.Opts.Name,
+		Pause: c.Opts.Pause,
+	}
+
+	if len(c.Opts.PlanParameters) > 0 && c.Opts.PlanId == nil {
+		return args, errors.New("plan parameters can only be set together with --plan")
+	}
+	if c.Opts.PlanId != nil {
+		params, err := createcmd.ParseServicePlanParameters(c.Opts.PlanParameters)
+		if err != nil {
+			return args, fmt.Errorf("failed to parse plan parameters: %w", err)
+		}
+		args.Plan = &api.ManagedServicePlan{Id: *c.Opts.PlanId, Parameters: params}
+	}
+
+	if len(c.Opts.Config) > 0 {
+		config, err := createcmd.ParseServiceValues(c.Opts.Config)
+		if err != nil {
+			return args, fmt.Errorf("failed to parse config: %w", err)
+		}
+		args.Config = config
+	}
+	if len(c.Opts.Secrets) > 0 {
+		secrets, err := c.SecretSource.ParseSecrets(c.Opts.Secrets)
+		if err != nil {
+			return args, fmt.Errorf("failed to parse secrets: %w", err)
+		}
+		args.Secrets = secrets
+	}
+
+	if args.Name == nil && args.Pause == nil && args.Plan == nil && args.Config == nil && args.Secrets == nil {
+		return args, errors.New("nothing to update, set at least one of --name, --plan, --config, --secret or --pause")
+	}
+	return args, nil
+}
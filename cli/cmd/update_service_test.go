@@ -0,0 +1,94 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("UpdateService", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.UpdateServiceCmd
+		serviceId  string
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		serviceId = "fake-id"
+		c = &cmd.UpdateServiceCmd{
+			Opts: cmd.UpdateServiceOpts{
+				GlobalOptions: &cmd.GlobalOptions{},
+				ServiceId:     serviceId,
+			},
+		}
+	})
+
+	It("only sends the changed values", func() {
+		name := "new-name"
+		pause := true
+		c.Opts.Name = &name
+		c.Opts.Pause = &pause
+
+		mockClient.EXPECT().UpdateManagedService(serviceId, api.UpdateManagedServiceArgs{Name: &name, Pause: &pause}).
+			Return(&api.ManagedService{Id: serviceId, Name: name}, nil)
+
+		err := c.UpdateService(mockClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("updates plan and config", func() {
+		planId := 2
+		c.Opts.PlanId = &planId
+		c.Opts.PlanParameters = []string{"storage=20"}
+		c.Opts.Config = []string{"max_connections=100"}
+
+		mockClient.EXPECT().UpdateManagedService(serviceId, api.UpdateManagedServiceArgs{
+			Plan:   &api.ManagedServicePlan{Id: 2, Parameters: map[string]int{"storage": 20}},
+			Config: map[string]interface{}{"max_connections": float64(100)},
+		}).Return(&api.ManagedService{Id: serviceId}, nil)
+
+		err := c.UpdateService(mockClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("sends secrets as string", func() {
+		c.Opts.Secrets = []string{"password=123456"}
+
+		mockClient.EXPECT().UpdateManagedService(serviceId, api.UpdateManagedServiceArgs{
+			Secrets: map[string]interface{}{"password": "123456"},
+		}).Return(&api.ManagedService{Id: serviceId}, nil)
+
+		err := c.UpdateService(mockClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails when plan parameters are given without plan", func() {
+		c.Opts.PlanParameters = []string{"storage=20"}
+
+		err := c.UpdateService(mockClient)
+		Expect(err).To(MatchError("plan parameters can only be set together with --plan"))
+	})
+
+	It("fails when nothing is updated", func() {
+		err := c.UpdateService(mockClient)
+		Expect(err).To(MatchError(ContainSubstring("nothing to update")))
+	})
+
+	It("returns API errors", func() {
+		name := "new-name"
+		c.Opts.Name = &name
+		mockClient.EXPECT().UpdateManagedService(serviceId, api.UpdateManagedServiceArgs{Name: &name}).
+			Return(nil, fmt.Errorf("not found"))
+
+		err := c.UpdateService(mockClient)
+		Expect(err).To(MatchError("failed to update managed service: not found"))
+	})
+})
@@ -0,0 +1,107 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	cserrors "github.com/codesphere-cloud/cs-go/api/errors"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type UpdateTeamMemberCmd struct {
+	cmd  *cobra.Command
+	Opts UpdateTeamMemberOpts
+}
+
+type UpdateTeamMemberOpts struct {
+	*GlobalOptions
+	UserId int
+	Email  string
+	Role   string
+}
+
+func (c *UpdateTeamMemberCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.UpdateTeamMember(client, teamId)
+}
+
+func AddUpdateTeamMemberCmd(update *cobra.Command, opts *GlobalOptions) {
+	member := UpdateTeamMemberCmd{
+		cmd: &cobra.Command{
+			Use:   "team-member",
+			Short: "Update team member",
+			Long: io.Long(`Change the role of a team member.
+
+				The member can be selected by user ID or email address.`),
+			Example: io.FormatExampleCommands("update team-member", []io.Example{
+				{Cmd: "-t <team-id> --email user@example.com --role admin", Desc: "Promote a team member to admin"},
+				{Cmd: "-t <team-id> --user-id <user-id> --role member", Desc: "Demote a team admin to member"},
+			}),
+		},
+		Opts: UpdateTeamMemberOpts{GlobalOptions: opts},
+	}
+	member.cmd.Flags().IntVarP(&member.Opts.UserId, "user-id", "u", 0, "Team member user ID")
+	member.cmd.Flags().StringVarP(&member.Opts.Email, "email", "e", "", "Team member email")
+	member.cmd.Flags().StringVarP(&member.Opts.Role, "role", "r", "", "New role of the team member (admin or member)")
+	member.cmd.MarkFlagsOneRequired("user-id", "email")
+	member.cmd.MarkFlagsMutuallyExclusive("user-id", "email")
+	_ = member.cmd.MarkFlagRequired("role")
+	member.cmd.RunE = member.RunE
+	update.AddCommand(member.cmd)
+}
+
+func (c *UpdateTeamMemberCmd) UpdateTeamMember(client Client, teamId int) error {
+	role := cs.TeamRoleFromName(c.Opts.Role)
+	if !role.IsValid() {
+		return fmt.Errorf("invalid role '%s': must be admin or member", c.Opts.Role)
+	}
+
+	userId, err := c.resolveUserId(client, teamId)
+	if err != nil {
+		return err
+	}
+
+	err = client.ChangeTeamMemberRole(teamId, userId, int(role))
+	if err != nil {
+		return fmt.Errorf("failed to change role of team member: %w", err)
+	}
+
+	log.Printf("Role of user %d in team %d changed to %s\n", userId, teamId, role)
+	return nil
+}
+
+func (c *UpdateTeamMemberCmd) resolveUserId(client Client, teamId int) (int, error) {
+	if c.Opts.UserId > 0 {
+		return c.Opts.UserId, nil
+	}
+	if c.Opts.Email == "" {
+		return 0, errors.New("either user ID or email has to be set")
+	}
+
+	members, err := client.ListTeamMembers(teamId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list team members: %w", err)
+	}
+	for _, m := range members {
+		if m.Email != nil && strings.EqualFold(*m.Email, c.Opts.Email) {
+			return m.UserId, nil
+		}
+	}
+	return 0, cserrors.NotFound(fmt.Sprintf("no member with email %s found in team %d", c.Opts.Email, teamId))
+}
@@ -0,0 +1,70 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+)
+
+var _ = Describe("UpdateTeamMember", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.UpdateTeamMemberCmd
+		teamId     int
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		teamId = 5
+		c = &cmd.UpdateTeamMemberCmd{
+			Opts: cmd.UpdateTeamMemberOpts{GlobalOptions: &cmd.GlobalOptions{}},
+		}
+	})
+
+	It("changes the role of a member given by user ID", func() {
+		c.Opts.UserId = 12
+		c.Opts.Role = "Admin"
+		mockClient.EXPECT().ChangeTeamMemberRole(teamId, 12, int(cs.RoleAdmin)).Return(nil)
+
+		err := c.UpdateTeamMember(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("resolves the user ID by email", func() {
+		email := "User@Example.com"
+		other := "other@example.com"
+		c.Opts.Email = "user@example.com"
+		c.Opts.Role = "member"
+		mockClient.EXPECT().ListTeamMembers(teamId).Return([]api.TeamMember{
+			{UserId: 11, Email: &other},
+			{UserId: 12, Email: &email},
+		}, nil)
+		mockClient.EXPECT().ChangeTeamMemberRole(teamId, 12, int(cs.RoleMember)).Return(nil)
+
+		err := c.UpdateTeamMember(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails if no member has the email", func() {
+		c.Opts.Email = "unknown@example.com"
+		c.Opts.Role = "member"
+		mockClient.EXPECT().ListTeamMembers(teamId).Return([]api.TeamMember{}, nil)
+
+		err := c.UpdateTeamMember(mockClient, teamId)
+		Expect(err).To(MatchError("no member with email unknown@example.com found in team 5"))
+	})
+
+	It("fails on invalid roles", func() {
+		c.Opts.UserId = 12
+		c.Opts.Role = "owner"
+
+		err := c.UpdateTeamMember(mockClient, teamId)
+		Expect(err).To(MatchError("invalid role 'owner': must be admin or member"))
+	})
+})
@@ -0,0 +1,182 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/spf13/cobra"
+)
+
+type UpdateWorkspaceCmd struct {
+	cmd  *cobra.Command
+	Opts UpdateWorkspaceOpts
+}
+
+type UpdateWorkspaceOpts struct {
+	*GlobalOptions
+	Name            *string // nil to keep the current name
+	Plan            *string // plan ID or name, nil to keep the current plan
+	BaseImage       *string // nil to keep the current base image
+	StorageMib      *int    // nil to keep the current storage size
+	Restricted      *bool   // nil to keep the current restriction
+	VpnConfig       *string // nil to keep, empty to remove the current VPN config
+	SharedVaultName *string // nil to keep, empty to remove the current shared vault
+}
+
+func (c *UpdateWorkspaceCmd) RunE(cmd *cobra.Command, args []string) error {
+	flags := cmd.Flags()
+	if flags.Changed("name") {
+		v, _ := flags.GetString("name")
+		c.Opts.Name = &v
+	}
+	if flags.Changed("plan") {
+		v, _ := flags.GetString("plan")
+		c.Opts.Plan = &v
+	}
+	if flags.Changed("base-image") {
+		v, _ := flags.GetString("base-image")
+		c.Opts.BaseImage = &v
+	}
+	if flags.Changed("storage") {
+		v, _ := flags.GetInt("storage")
+		c.Opts.StorageMib = &v
+	}
+	if flags.Changed("restricted") {
+		v, _ := flags.GetBool("restricted")
+		c.Opts.Restricted = &v
+	}
+	if flags.Changed("vpn-config") {
+		v, _ := flags.GetString("vpn-config")
+		c.Opts.VpnConfig = &v
+	}
+	if flags.Changed("shared-vault") {
+		v, _ := flags.GetString("shared-vault")
+		c.Opts.SharedVaultName = &v
+	}
+
+	wsId, err := c.Opts.GetWorkspaceId()
+	if err != nil {
+		return fmt.Errorf("failed to get workspace ID: %w", err)
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.UpdateWorkspace(client, wsId)
+}
+
+func AddUpdateWorkspaceCmd(update *cobra.Command, opts *GlobalOptions) {
+	workspace := UpdateWorkspaceCmd{
+		cmd: &cobra.Command{
+			Use:   "workspace",
+			Short: "Update workspace",
+			Long: io.Long(`Update name, plan, base image, storage, restriction, VPN config or shared vault of a workspace.
+
+				Only the given values are changed, all other settings are kept.
+				The plan can be given by ID or by name, run 'cs list plans' to see available plans.
+				Pass an empty value to --vpn-config or --shared-vault to remove it from the workspace.`),
+			Example: io.FormatExampleCommands("update workspace", []io.Example{
+				{Cmd: "-w <workspace-id> --name new-name", Desc: "Rename a workspace"},
+				{Cmd: "-w <workspace-id> --plan Pro", Desc: "Change the plan of a workspace by plan name"},
+				{Cmd: "-w <workspace-id> --plan 21 --storage 20480", Desc: "Change plan by ID and resize storage to 20 GiB"},
+				{Cmd: "-w <workspace-id> --restricted=false", Desc: "Lift the restriction of a workspace"},
+				{Cmd: "-w <workspace-id> --vpn-config ''", Desc: "Remove the VPN config of a workspace"},
+			}),
+		},
+		Opts: UpdateWorkspaceOpts{GlobalOptions: opts},
+	}
+	flags := workspace.cmd.Flags()
+	flags.StringP("name", "n", "", "New name of the workspace")
+	flags.StringP("plan", "p", "", "New plan of the workspace, either plan ID or plan name")
+	flags.String("base-image", "", "New base image of the workspace")
+	flags.Int("storage", 0, "New storage size of the workspace in MiB")
+	flags.Bool("restricted", false, "Restrict access to the workspace to team members (--restricted=false to lift)")
+	flags.String("vpn-config", "", "Name of the VPN config to use, empty to remove")
+	flags.String("shared-vault", "", "Name of the shared vault to use, empty to remove")
+	_ = workspace.cmd.RegisterFlagCompletionFunc("plan", shared.CompletePlans(opts))
+	_ = workspace.cmd.RegisterFlagCompletionFunc("base-image", shared.CompleteBaseimages(opts))
+	workspace.cmd.RunE = workspace.RunE
+	update.AddCommand(workspace.cmd)
+}
+
+func (c *UpdateWorkspaceCmd) UpdateWorkspace(client Client, wsId int) error {
+	args, err := c.buildUpdateArgs(client)
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdateWorkspace(wsId, args)
+	if err != nil {
+		return fmt.Errorf("failed to update workspace: %w", err)
+	}
+
+	log.Printf("Workspace %d updated\n", wsId)
+	return nil
+}
+
+func (c *UpdateWorkspaceCmd) buildUpdateArgs(client Client) (api.UpdateWorkspaceArgs, error) {
+	args := api.UpdateWorkspaceArgs{
+		Name:       c.Opts.Name,
+		BaseImage:  c.Opts.BaseImage,
+		Restricted: c.Opts.Restricted,
+	}
+	changed := c.Opts.Name != nil || c.Opts.BaseImage != nil || c.Opts.Restricted != nil
+
+	if c.Opts.Plan != nil {
+		planId, err := resolvePlanId(client, *c.Opts.Plan)
+		if err != nil {
+			return args, err
+		}
+		args.PlanId = &planId
+		changed = true
+	}
+	if c.Opts.StorageMib != nil {
+		if *c.Opts.StorageMib <= 0 {
+			return args, errors.New("storage must be a positive number of MiB")
+		}
+		args.StorageMib.Set(c.Opts.StorageMib)
+		changed = true
+	}
+	if c.Opts.VpnConfig != nil {
+		args.VpnConfig.Set(emptyToNil(*c.Opts.VpnConfig))
+		changed = true
+	}
+	if c.Opts.SharedVaultName != nil {
+		args.SharedVaultName.Set(emptyToNil(*c.Opts.SharedVaultName))
+		changed = true
+	}
+
+	if !changed {
+		return args, errors.New("nothing to update, set at least one of --name, --plan, --base-image, --storage, --restricted, --vpn-config or --shared-vault")
+	}
+	return args, nil
+}
+
+// resolvePlanId accepts either a numeric plan ID or the name of a plan.
+func resolvePlanId(client Client, plan string) (int, error) {
+	if id, err := strconv.Atoi(plan); err == nil {
+		return id, nil
+	}
+	p, err := client.PlanByName(plan)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find plan %s: %w", plan, err)
+	}
+	return p.Id, nil
+}
+
+func emptyToNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("UpdateWorkspace", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.UpdateWorkspaceCmd
+		wsId       int
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		wsId = 42
+		c = &cmd.UpdateWorkspaceCmd{
+			Opts: cmd.UpdateWorkspaceOpts{
+				GlobalOptions: &cmd.GlobalOptions{},
+			},
+		}
+	})
+
+	It("only sends the changed values", func() {
+		name := "new-name"
+		restricted := false
+		c.Opts.Name = &name
+		c.Opts.Restricted = &restricted
+
+		mockClient.EXPECT().UpdateWorkspace(wsId, api.UpdateWorkspaceArgs{Name: &name, Restricted: &restricted}).Return(nil)
+
+		err := c.UpdateWorkspace(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("resolves the plan by name and sets storage", func() {
+		plan := "Pro"
+		storage := 20480
+		c.Opts.Plan = &plan
+		c.Opts.StorageMib = &storage
+
+		mockClient.EXPECT().PlanByName("Pro").Return(api.WorkspacePlan{Id: 21, Title: "Pro"}, nil)
+		mockClient.EXPECT().UpdateWorkspace(wsId, mock.Anything).RunAndReturn(func(_ int, args api.UpdateWorkspaceArgs) error {
+			Expect(*args.PlanId).To(Equal(21))
+			Expect(*args.StorageMib.Get()).To(Equal(storage))
+			Expect(args.VpnConfig.IsSet()).To(BeFalse())
+			return nil
+		})
+
+		err := c.UpdateWorkspace(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("accepts plan IDs without looking up the plan", func() {
+		plan := "8"
+		c.Opts.Plan = &plan
+
+		mockClient.EXPECT().UpdateWorkspace(wsId, mock.Anything).RunAndReturn(func(_ int, args api.UpdateWorkspaceArgs) error {
+			Expect(*args.PlanId).To(Equal(8))
+			return nil
+		})
+
+		err := c.UpdateWorkspace(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("removes the VPN config on empty value", func() {
+		vpn := ""
+		c.Opts.VpnConfig = &vpn
+
+		mockClient.EXPECT().UpdateWorkspace(wsId, mock.Anything).RunAndReturn(func(_ int, args api.UpdateWorkspaceArgs) error {
+			Expect(args.VpnConfig.IsSet()).To(BeTrue())
+			Expect(args.VpnConfig.Get()).To(BeNil())
+			return nil
+		})
+
+		err := c.UpdateWorkspace(mockClient, wsId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails on unknown plans", func() {
+		plan := "Unknown"
+		c.Opts.Plan = &plan
+		mockClient.EXPECT().PlanByName("Unknown").Return(api.WorkspacePlan{}, errors.New("not found"))
+
+		err := c.UpdateWorkspace(mockClient, wsId)
+		Expect(err).To(MatchError("failed to find plan Unknown: not found"))
+	})
+
+	It("fails if nothing is changed", func() {
+		err := c.UpdateWorkspace(mockClient, wsId)
+		Expect(err).To(MatchError(ContainSubstring("nothing to update")))
+	})
+})
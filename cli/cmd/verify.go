@@ -0,0 +1,25 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+type VerifyCmd struct {
+	cmd *cobra.Command
+}
+
+func AddVerifyCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
+	verify := VerifyCmd{
+		cmd: &cobra.Command{
+			Use:   "verify",
+			Short: "Verify Codesphere resources",
+			Long:  `Verify Codesphere resources, like custom domains.`,
+		},
+	}
+	rootCmd.AddCommand(verify.cmd)
+
+	AddVerifyDomainCmd(verify.cmd, opts)
+}
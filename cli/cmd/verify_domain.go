@@ -0,0 +1,101 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/pkg/io"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+type VerifyDomainCmd struct {
+	cmd  *cobra.Command
+	Opts VerifyDomainOpts
+}
+
+type VerifyDomainOpts struct {
+	*GlobalOptions
+	Name string
+}
+
+func (c *VerifyDomainCmd) RunE(_ *cobra.Command, args []string) error {
+	teamId, err := c.Opts.GetTeamId()
+	if err != nil {
+		return fmt.Errorf("failed to get team ID: %w", err)
+	}
+
+	client, err := NewClient(*c.Opts.GlobalOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create Codesphere client: %w", err)
+	}
+
+	return c.VerifyDomain(client, teamId)
+}
+
+func AddVerifyDomainCmd(verify *cobra.Command, opts *GlobalOptions) {
+	domain := VerifyDomainCmd{
+		cmd: &cobra.Command{
+			Use:   "domain",
+			Short: "Verify custom domain",
+			Long: io.Long(`Trigger the DNS verification of a custom domain.
+
+				If the domain is not verified yet, the DNS records that still need to be set are printed.`),
+			Example: io.FormatExampleCommands("verify domain", []io.Example{
+				{Cmd: "-t <team-id> -n app.example.com", Desc: "Verify a custom domain"},
+			}),
+		},
+		Opts: VerifyDomainOpts{GlobalOptions: opts},
+	}
+	domain.cmd.Flags().StringVarP(&domain.Opts.Name, "name", "n", "", "Domain name")
+	_ = domain.cmd.MarkFlagRequired("name")
+	domain.cmd.RunE = domain.RunE
+	verify.AddCommand(domain.cmd)
+}
+
+func (c *VerifyDomainCmd) VerifyDomain(client Client, teamId int) error {
+	status, err := client.VerifyDomain(teamId, c.Opts.Name)
+	if err != nil {
+		return fmt.Errorf("failed to verify domain: %w", err)
+	}
+
+	if status.Verified {
+		log.Printf("Domain %s is verified\n", c.Opts.Name)
+		return nil
+	}
+
+	domain, err := client.GetDomain(teamId, c.Opts.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get domain %s: %w", c.Opts.Name, err)
+	}
+
+	reason := status.Reason.Get()
+	if reason != nil && *reason != "" {
+		log.Printf("Domain %s is not verified yet: %s\n", c.Opts.Name, *reason)
+	} else {
+		log.Printf("Domain %s is not verified yet\n", c.Opts.Name)
+	}
+	log.Println("Please make sure the following DNS records are set (either A or CNAME, and TXT):")
+	printMissingDnsRecords(domain)
+
+	return fmt.Errorf("domain %s is not verified", c.Opts.Name)
+}
+
+func printMissingDnsRecords(domain *api.Domain) {
+	t := io.GetTableWriter()
+	t.AppendHeader(table.Row{"Type", "Name", "Value"})
+	if domain.DnsEntries.A != "" {
+		t.AppendRow(table.Row{"A", domain.Name, domain.DnsEntries.A})
+	}
+	if domain.DnsEntries.Cname != "" {
+		t.AppendRow(table.Row{"CNAME", domain.Name, domain.DnsEntries.Cname})
+	}
+	if domain.DnsEntries.Txt != "" {
+		t.AppendRow(table.Row{"TXT", domain.Name, domain.DnsEntries.Txt})
+	}
+	t.Render()
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/codesphere-cloud/cs-go/api"
+	"github.com/codesphere-cloud/cs-go/api/openapi_client"
+	"github.com/codesphere-cloud/cs-go/cli/cmd"
+)
+
+var _ = Describe("VerifyDomain", func() {
+	var (
+		mockClient *cmd.MockClient
+		c          *cmd.VerifyDomainCmd
+		teamId     int
+		domainName string
+	)
+
+	BeforeEach(func() {
+		mockClient = cmd.NewMockClient(GinkgoT())
+		teamId = 5
+		domainName = "app.example.com"
+		c = &cmd.VerifyDomainCmd{
+			Opts: cmd.VerifyDomainOpts{
+				GlobalOptions: &cmd.GlobalOptions{},
+				Name:          domainName,
+			},
+		}
+	})
+
+	It("succeeds for verified domains", func() {
+		mockClient.EXPECT().VerifyDomain(teamId, domainName).Return(&api.DomainVerificationStatus{Verified: true}, nil)
+
+		err := c.VerifyDomain(mockClient, teamId)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fetches the DNS records of unverified domains", func() {
+		mockClient.EXPECT().VerifyDomain(teamId, domainName).Return(&api.DomainVerificationStatus{
+			Verified: false,
+			Reason:   *openapi_client.NewNullableString(nil),
+		}, nil)
+		mockClient.EXPECT().GetDomain(teamId, domainName).Return(&api.Domain{
+			Name: domainName,
+		}, nil)
+
+		err := c.VerifyDomain(mockClient, teamId)
+		Expect(err).To(MatchError("domain app.example.com is not verified"))
+	})
+})
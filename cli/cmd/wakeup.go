@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"time"
 
+	shared "github.com/codesphere-cloud/cs-go/cli/cmd/shared"
 	"github.com/codesphere-cloud/cs-go/pkg/io"
 	"github.com/spf13/cobra"
 )
@@ -60,6 +61,7 @@ func AddWakeUpCmd(rootCmd *cobra.Command, opts *GlobalOptions) {
 	wakeup.cmd.Flags().DurationVar(&wakeup.Opts.Timeout, "timeout", 120*time.Second, "Timeout for waking up the workspace")
 	wakeup.cmd.Flags().BoolVar(&wakeup.Opts.SyncLandscape, "sync-landscape", false, "Deploy landscape from CI profile after waking up")
 	wakeup.cmd.Flags().StringVarP(&wakeup.Opts.Profile, "profile", "p", "", "CI profile to use for landscape deploy (e.g. 'prod' for ci.prod.yml)")
+	_ = wakeup.cmd.RegisterFlagCompletionFunc("profile", shared.CompleteProfiles)
 	rootCmd.AddCommand(wakeup.cmd)
 	wakeup.cmd.RunE = wakeup.RunE
 }
@@ -116,7 +118,7 @@ func (c *WakeUpCmd) WakeUpWorkspace(client Client, wsId int) error {
 
 	log.Printf("Checking health of workspace %d (%s)...\n", wsId, workspace.Name)
 
-	token, err := c.Opts.Env.GetApiToken()
+	token, err := c.Opts.GetApiToken()
 	if err != nil {
 		return fmt.Errorf("failed to get API token: %w", err)
 	}
@@ -0,0 +1,84 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// NameCacheTTL is how long resolved names are cached.
+const NameCacheTTL = 10 * time.Minute
+
+// NameCache caches IDs of resolved team and workspace names across CLI invocations.
+type NameCache struct {
+	path    string
+	now     func() time.Time
+	Entries map[string]NameCacheEntry `json:"entries"`
+}
+
+type NameCacheEntry struct {
+	Id      int       `json:"id"`
+	Expires time.Time `json:"expires"`
+}
+
+// NameCachePath returns the path of the name cache, ~/.cache/cs/names.json on Linux.
+func NameCachePath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get cache directory: %w", err)
+	}
+	return filepath.Join(cacheDir, "cs", "names.json"), nil
+}
+
+// LoadNameCache reads the cache at path. A missing or corrupt file results in an empty cache.
+func LoadNameCache(path string, now func() time.Time) *NameCache {
+	cache := &NameCache{path: path, now: now, Entries: map[string]NameCacheEntry{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cache
+	}
+	if err := json.Unmarshal(data, cache); err != nil || cache.Entries == nil {
+		cache.Entries = map[string]NameCacheEntry{}
+	}
+	return cache
+}
+
+// Get returns the cached ID for key, if not expired.
+func (c *NameCache) Get(key string) (int, bool) {
+	entry, ok := c.Entries[key]
+	if !ok || c.now().After(entry.Expires) {
+		return 0, false
+	}
+	return entry.Id, true
+}
+
+// Set caches the ID for key and drops expired entries.
+func (c *NameCache) Set(key string, id int) {
+	now := c.now()
+	for k, entry := range c.Entries {
+		if now.After(entry.Expires) {
+			delete(c.Entries, k)
+		}
+	}
+	c.Entries[key] = NameCacheEntry{Id: id, Expires: now.Add(NameCacheTTL)}
+}
+
+// Save writes the cache to its path.
+func (c *NameCache) Save() error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal name cache: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	if err := os.WriteFile(c.path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write name cache %s: %w", c.path, err)
+	}
+	return nil
+}
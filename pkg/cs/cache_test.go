@@ -0,0 +1,46 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs_test
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NameCache", func() {
+	var (
+		path string
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "cs", "names.json")
+		now = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	clock := func() time.Time { return now }
+
+	It("persists entries", func() {
+		cache := cs.LoadNameCache(path, clock)
+		cache.Set("team|frontend", 1)
+		Expect(cache.Save()).To(Succeed())
+
+		id, ok := cs.LoadNameCache(path, clock).Get("team|frontend")
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal(1))
+	})
+
+	It("expires entries", func() {
+		cache := cs.LoadNameCache(path, clock)
+		cache.Set("team|frontend", 1)
+
+		now = now.Add(cs.NameCacheTTL + time.Second)
+		_, ok := cache.Get("team|frontend")
+		Expect(ok).To(BeFalse())
+	})
+})
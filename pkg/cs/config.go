@@ -0,0 +1,177 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ConfigKeys are the keys which can be set per context.
+var ConfigKeys = []string{"api", "token", "team", "workspace", "org"}
+
+// Config is the persistent CLI configuration, holding named contexts,
+// e.g. one per Codesphere installation.
+type Config struct {
+	CurrentContext string              `yaml:"current-context,omitempty"`
+	Contexts       map[string]*Context `yaml:"contexts,omitempty"`
+}
+
+// Context holds default values for global options.
+// Zero values are treated as unset.
+type Context struct {
+	ApiUrl      string `yaml:"api,omitempty"`
+	Token       string `yaml:"token,omitempty"`
+	TeamId      int    `yaml:"team,omitempty"`
+	WorkspaceId int    `yaml:"workspace,omitempty"`
+	OrgId       string `yaml:"org,omitempty"`
+}
+
+// ConfigPath returns the path of the config file.
+// It can be overridden by the CS_CONFIG environment variable and defaults to ~/.config/cs/config.yaml,
+// respecting XDG_CONFIG_HOME.
+func ConfigPath() (string, error) {
+	if path := os.Getenv("CS_CONFIG"); path != "" {
+		return path, nil
+	}
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to get home directory: %w", err)
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(configDir, "cs", "config.yaml"), nil
+}
+
+// LoadConfig reads the config file at path. A missing file results in an empty config.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{Contexts: map[string]*Context{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return config, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	if config.Contexts == nil {
+		config.Contexts = map[string]*Context{}
+	}
+	return config, nil
+}
+
+// Save writes the config to path. The file is only readable by the current user as it may contain tokens.
+func (c *Config) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// ActiveContextName returns the name of the active context.
+// The CS_CONTEXT environment variable takes precedence over the current context of the config file.
+func (c *Config) ActiveContextName() string {
+	if name := os.Getenv("CS_CONTEXT"); name != "" {
+		return name
+	}
+	return c.CurrentContext
+}
+
+// ActiveContext returns the active context or nil if no context is active.
+func (c *Config) ActiveContext() *Context {
+	return c.Contexts[c.ActiveContextName()]
+}
+
+// ContextNames returns the names of all contexts, sorted alphabetically.
+func (c *Config) ContextNames() []string {
+	names := make([]string, 0, len(c.Contexts))
+	for name := range c.Contexts {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Get returns the value of a config key, empty if unset.
+func (c *Context) Get(key string) (string, error) {
+	switch key {
+	case "api":
+		return c.ApiUrl, nil
+	case "token":
+		return c.Token, nil
+	case "team":
+		return idToString(c.TeamId), nil
+	case "workspace":
+		return idToString(c.WorkspaceId), nil
+	case "org":
+		return c.OrgId, nil
+	}
+	return "", unknownKeyError(key)
+}
+
+// Set sets the value of a config key. An empty value unsets the key.
+func (c *Context) Set(key string, value string) error {
+	switch key {
+	case "api":
+		c.ApiUrl = value
+	case "token":
+		c.Token = value
+	case "team":
+		id, err := parseId(key, value)
+		if err != nil {
+			return err
+		}
+		c.TeamId = id
+	case "workspace":
+		id, err := parseId(key, value)
+		if err != nil {
+			return err
+		}
+		c.WorkspaceId = id
+	case "org":
+		c.OrgId = value
+	default:
+		return unknownKeyError(key)
+	}
+	return nil
+}
+
+func idToString(id int) string {
+	if id <= 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+func parseId(key string, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	id, err := strconv.Atoi(value)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid %s ID '%s', expected a positive number", key, value)
+	}
+	return id, nil
+}
+
+func unknownKeyError(key string) error {
+	return fmt.Errorf("unknown config key '%s', valid keys are: %v", key, ConfigKeys)
+}
@@ -0,0 +1,117 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "cs", "config.yaml")
+		GinkgoT().Setenv("CS_CONTEXT", "")
+	})
+
+	It("returns an empty config if the file doesn't exist", func() {
+		config, err := cs.LoadConfig(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Contexts).To(BeEmpty())
+		Expect(config.ActiveContext()).To(BeNil())
+	})
+
+	It("saves and loads contexts", func() {
+		config := &cs.Config{
+			CurrentContext: "saas",
+			Contexts: map[string]*cs.Context{
+				"saas":    {Token: "secret", TeamId: 42},
+				"on-prem": {ApiUrl: "https://cs.example.com/api"},
+			},
+		}
+		Expect(config.Save(path)).To(Succeed())
+
+		info, err := os.Stat(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		loaded, err := cs.LoadConfig(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded).To(Equal(config))
+		Expect(loaded.ContextNames()).To(Equal([]string{"on-prem", "saas"}))
+		Expect(loaded.ActiveContext().TeamId).To(Equal(42))
+	})
+
+	It("prefers CS_CONTEXT over the current context", func() {
+		GinkgoT().Setenv("CS_CONTEXT", "on-prem")
+		config := &cs.Config{
+			CurrentContext: "saas",
+			Contexts:       map[string]*cs.Context{"saas": {}, "on-prem": {OrgId: "org"}},
+		}
+		Expect(config.ActiveContext().OrgId).To(Equal("org"))
+	})
+
+	It("sets and gets values by key", func() {
+		ctx := &cs.Context{}
+		Expect(ctx.Set("team", "42")).To(Succeed())
+		Expect(ctx.Set("api", "https://cs.example.com/api")).To(Succeed())
+		Expect(ctx.Get("team")).To(Equal("42"))
+		Expect(ctx.Get("workspace")).To(Equal(""))
+		Expect(ctx.Get("api")).To(Equal("https://cs.example.com/api"))
+
+		Expect(ctx.Set("team", "")).To(Succeed())
+		Expect(ctx.TeamId).To(Equal(0))
+
+		Expect(ctx.Set("team", "abc")).To(MatchError("invalid team ID 'abc', expected a positive number"))
+		Expect(ctx.Set("unknown", "x")).To(MatchError(ContainSubstring("unknown config key 'unknown'")))
+	})
+})
+
+var _ = Describe("Environment", func() {
+	BeforeEach(func() {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		GinkgoT().Setenv("CS_CONFIG", path)
+		GinkgoT().Setenv("CS_CONTEXT", "")
+		for _, env := range []string{"CS_TOKEN", "CS_API", "CS_TEAM_ID", "CS_WORKSPACE_ID", "WORKSPACE_ID", "CS_ORG_ID"} {
+			GinkgoT().Setenv(env, "")
+		}
+
+		config := &cs.Config{
+			CurrentContext: "on-prem",
+			Contexts: map[string]*cs.Context{
+				"on-prem": {ApiUrl: "https://cs.example.com/api", Token: "ctx-token", TeamId: 5, WorkspaceId: 7, OrgId: "ctx-org"},
+			},
+		}
+		Expect(config.Save(path)).To(Succeed())
+	})
+
+	It("falls back to the active context", func() {
+		env := cs.NewEnv()
+		Expect(env.GetApiUrl()).To(Equal("https://cs.example.com/api"))
+		Expect(env.GetApiToken()).To(Equal("ctx-token"))
+		Expect(env.GetTeamId()).To(Equal(5))
+		Expect(env.GetWorkspaceId()).To(Equal(7))
+		Expect(env.GetOrgId()).To(Equal("ctx-org"))
+	})
+
+	It("prefers environment variables over the active context", func() {
+		GinkgoT().Setenv("CS_API", "https://env.example.com/api")
+		GinkgoT().Setenv("CS_TOKEN", "env-token")
+		GinkgoT().Setenv("CS_TEAM_ID", "6")
+		GinkgoT().Setenv("WORKSPACE_ID", "8")
+		GinkgoT().Setenv("CS_ORG_ID", "env-org")
+
+		env := cs.NewEnv()
+		Expect(env.GetApiUrl()).To(Equal("https://env.example.com/api"))
+		Expect(env.GetApiToken()).To(Equal("env-token"))
+		Expect(env.GetTeamId()).To(Equal(6))
+		Expect(env.GetWorkspaceId()).To(Equal(8))
+		Expect(env.GetOrgId()).To(Equal("env-org"))
+	})
+})
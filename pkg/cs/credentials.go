@@ -0,0 +1,259 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+)
+
+// ErrNoCredentials is returned by credential stores if no token is stored for an API URL.
+var ErrNoCredentials = errors.New("no stored credentials")
+
+// CredentialStore persists API tokens per API URL.
+type CredentialStore interface {
+	Get(apiUrl string) (string, error)
+	Set(apiUrl string, token string) error
+	Delete(apiUrl string) error
+}
+
+// NewCredentialStore returns the credential store selected by the CS_CREDENTIAL_STORE environment variable.
+// Valid values are "keyring" and "file". By default, the OS keyring is used,
+// falling back to an encrypted file if the keyring is unavailable, e.g. on headless Linux.
+func NewCredentialStore() (CredentialStore, error) {
+	file, err := NewFileStore()
+	if err != nil {
+		return nil, err
+	}
+	switch store := os.Getenv("CS_CREDENTIAL_STORE"); store {
+	case "keyring":
+		return &KeyringStore{}, nil
+	case "file":
+		return file, nil
+	case "":
+		return &fallbackStore{primary: &KeyringStore{}, fallback: file}, nil
+	default:
+		return nil, fmt.Errorf("invalid credential store '%s', expected keyring or file", store)
+	}
+}
+
+func credentialKey(apiUrl string) string {
+	return strings.TrimSuffix(apiUrl, "/")
+}
+
+const keyringService = "codesphere-cli"
+
+// KeyringStore stores tokens in the OS keyring, e.g. macOS Keychain, Windows Credential Manager or Secret Service on Linux.
+type KeyringStore struct{}
+
+func (s *KeyringStore) Get(apiUrl string) (string, error) {
+	token, err := keyring.Get(keyringService, credentialKey(apiUrl))
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrNoCredentials
+	}
+	return token, err
+}
+
+func (s *KeyringStore) Set(apiUrl string, token string) error {
+	return keyring.Set(keyringService, credentialKey(apiUrl), token)
+}
+
+func (s *KeyringStore) Delete(apiUrl string) error {
+	err := keyring.Delete(keyringService, credentialKey(apiUrl))
+	if errors.Is(err, keyring.ErrNotFound) {
+		return ErrNoCredentials
+	}
+	return err
+}
+
+// FileStore stores tokens in a file encrypted with AES-GCM.
+//
+// The key is derived from the CS_CREDENTIALS_PASSPHRASE environment variable if set.
+// Otherwise it is derived from the machine ID and home directory, which only protects against
+// the file being copied to a different machine, not against other processes of the same user.
+type FileStore struct {
+	Path       string
+	Passphrase string
+}
+
+// NewFileStore returns a file store next to the config file.
+func NewFileStore() (*FileStore, error) {
+	configPath, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	passphrase := os.Getenv("CS_CREDENTIALS_PASSPHRASE")
+	if passphrase == "" {
+		passphrase = machineSecret()
+	}
+	return &FileStore{
+		Path:       filepath.Join(filepath.Dir(configPath), "credentials"),
+		Passphrase: passphrase,
+	}, nil
+}
+
+func machineSecret() string {
+	secret := ""
+	for _, path := range []string{"/etc/machine-id", "/var/lib/dbus/machine-id"} {
+		if id, err := os.ReadFile(path); err == nil {
+			secret = strings.TrimSpace(string(id))
+			break
+		}
+	}
+	if secret == "" {
+		secret, _ = os.Hostname()
+	}
+	home, _ := os.UserHomeDir()
+	return secret + ":" + home
+}
+
+const (
+	fileStoreSaltSize   = 16
+	fileStoreIterations = 100_000
+)
+
+func (s *FileStore) Get(apiUrl string) (string, error) {
+	tokens, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	token, ok := tokens[credentialKey(apiUrl)]
+	if !ok {
+		return "", ErrNoCredentials
+	}
+	return token, nil
+}
+
+func (s *FileStore) Set(apiUrl string, token string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	tokens[credentialKey(apiUrl)] = token
+	return s.save(tokens)
+}
+
+func (s *FileStore) Delete(apiUrl string) error {
+	tokens, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[credentialKey(apiUrl)]; !ok {
+		return ErrNoCredentials
+	}
+	delete(tokens, credentialKey(apiUrl))
+	return s.save(tokens)
+}
+
+func (s *FileStore) cipher(salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, s.Passphrase, salt, fileStoreIterations, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *FileStore) load() (map[string]string, error) {
+	tokens := map[string]string{}
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file %s: %w", s.Path, err)
+	}
+	if len(data) < fileStoreSaltSize {
+		return nil, fmt.Errorf("invalid credentials file %s", s.Path)
+	}
+	salt, data := data[:fileStoreSaltSize], data[fileStoreSaltSize:]
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid credentials file %s", s.Path)
+	}
+	nonce, data := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credentials file %s, was CS_CREDENTIALS_PASSPHRASE changed?", s.Path)
+	}
+	if err := json.Unmarshal(plain, &tokens); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials file %s: %w", s.Path, err)
+	}
+	return tokens, nil
+}
+
+func (s *FileStore) save(tokens map[string]string) error {
+	plain, err := json.Marshal(tokens)
+	if err != nil {
+		return fmt.Errorf("failed to marshal credentials: %w", err)
+	}
+	salt := make([]byte, fileStoreSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	gcm, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	data := append(salt, gcm.Seal(nonce, nonce, plain, nil)...)
+
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0700); err != nil {
+		return fmt.Errorf("failed to create credentials directory: %w", err)
+	}
+	if err := os.WriteFile(s.Path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write credentials file %s: %w", s.Path, err)
+	}
+	return nil
+}
+
+// fallbackStore uses the fallback store if the primary store is unavailable.
+type fallbackStore struct {
+	primary  CredentialStore
+	fallback CredentialStore
+}
+
+func (s *fallbackStore) Get(apiUrl string) (string, error) {
+	token, err := s.primary.Get(apiUrl)
+	if err == nil {
+		return token, nil
+	}
+	return s.fallback.Get(apiUrl)
+}
+
+func (s *fallbackStore) Set(apiUrl string, token string) error {
+	if err := s.primary.Set(apiUrl, token); err != nil {
+		return s.fallback.Set(apiUrl, token)
+	}
+	return nil
+}
+
+func (s *fallbackStore) Delete(apiUrl string) error {
+	primaryErr := s.primary.Delete(apiUrl)
+	fallbackErr := s.fallback.Delete(apiUrl)
+	if primaryErr == nil || fallbackErr == nil {
+		return nil
+	}
+	return fallbackErr
+}
@@ -0,0 +1,127 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileStore", func() {
+	var store *cs.FileStore
+
+	BeforeEach(func() {
+		store = &cs.FileStore{
+			Path:       filepath.Join(GinkgoT().TempDir(), "cs", "credentials"),
+			Passphrase: "secret",
+		}
+	})
+
+	It("stores tokens per API URL", func() {
+		Expect(store.Set("https://cloud.codesphere.com/api/", "saas-token")).To(Succeed())
+		Expect(store.Set("https://cs.example.com/api", "on-prem-token")).To(Succeed())
+
+		Expect(store.Get("https://cloud.codesphere.com/api")).To(Equal("saas-token"))
+		Expect(store.Get("https://cs.example.com/api")).To(Equal("on-prem-token"))
+
+		info, err := os.Stat(store.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		data, err := os.ReadFile(store.Path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring("saas-token"))
+	})
+
+	It("deletes tokens", func() {
+		Expect(store.Set("https://cloud.codesphere.com/api", "token")).To(Succeed())
+		Expect(store.Delete("https://cloud.codesphere.com/api")).To(Succeed())
+
+		_, err := store.Get("https://cloud.codesphere.com/api")
+		Expect(err).To(MatchError(cs.ErrNoCredentials))
+		Expect(store.Delete("https://cloud.codesphere.com/api")).To(MatchError(cs.ErrNoCredentials))
+	})
+
+	It("fails to decrypt with a different passphrase", func() {
+		Expect(store.Set("https://cloud.codesphere.com/api", "token")).To(Succeed())
+
+		other := &cs.FileStore{Path: store.Path, Passphrase: "other"}
+		_, err := other.Get("https://cloud.codesphere.com/api")
+		Expect(err).To(MatchError(ContainSubstring("failed to decrypt credentials file")))
+	})
+})
+
+var _ = Describe("LookupApiToken", func() {
+	BeforeEach(func() {
+		GinkgoT().Setenv("CS_CONFIG", filepath.Join(GinkgoT().TempDir(), "config.yaml"))
+		GinkgoT().Setenv("CS_CONTEXT", "")
+		GinkgoT().Setenv("CS_CREDENTIAL_STORE", "file")
+		GinkgoT().Setenv("CS_CREDENTIALS_PASSPHRASE", "secret")
+		GinkgoT().Setenv("CS_TOKEN", "")
+	})
+
+	It("reads tokens from the credential store", func() {
+		store, err := cs.NewCredentialStore()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(store.Set("https://cs.example.com/api", "stored-token")).To(Succeed())
+
+		token, source, err := cs.NewEnv().LookupApiToken("https://cs.example.com/api")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("stored-token"))
+		Expect(source).To(Equal("credential store"))
+	})
+
+	It("prefers CS_TOKEN over stored tokens", func() {
+		GinkgoT().Setenv("CS_TOKEN", "env-token")
+
+		token, source, err := cs.NewEnv().LookupApiToken("https://cs.example.com/api")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("env-token"))
+		Expect(source).To(Equal("CS_TOKEN environment variable"))
+	})
+
+	Context("with an active context with token", func() {
+		BeforeEach(func() {
+			config := &cs.Config{
+				CurrentContext: "onprem-a",
+				Contexts: map[string]*cs.Context{
+					"onprem-a": {ApiUrl: "https://onprem-a.example.com/api", Token: "ctx-token"},
+				},
+			}
+			Expect(config.Save(os.Getenv("CS_CONFIG"))).To(Succeed())
+		})
+
+		It("uses the token of the context for its API URL", func() {
+			token, source, err := cs.NewEnv().LookupApiToken("https://onprem-a.example.com/api/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("ctx-token"))
+			Expect(source).To(Equal("context onprem-a"))
+		})
+
+		It("uses the stored token for a different API URL", func() {
+			store, err := cs.NewCredentialStore()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(store.Set("https://onprem-b.example.com/api", "stored-token")).To(Succeed())
+
+			token, source, err := cs.NewEnv().LookupApiToken("https://onprem-b.example.com/api")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(Equal("stored-token"))
+			Expect(source).To(Equal("credential store"))
+		})
+
+		It("doesn't send the token of the context to a different API URL", func() {
+			_, _, err := cs.NewEnv().LookupApiToken("https://onprem-b.example.com/api")
+			Expect(err).To(MatchError(cs.ErrNoApiToken))
+		})
+	})
+
+	It("fails if no token is found", func() {
+		_, _, err := cs.NewEnv().LookupApiToken("https://cs.example.com/api")
+		Expect(err).To(MatchError("no API token found for https://cs.example.com/api, set CS_TOKEN or run 'cs auth login'"))
+	})
+})
@@ -0,0 +1,141 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+var (
+	dotEnvUnescaper = strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n", `\r`, "\r", `\t`, "\t")
+	dotEnvEscaper   = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+)
+
+// ParseDotEnv reads environment variables in .env syntax.
+//
+// Supported are comments, an optional 'export' prefix, unquoted values with inline comments,
+// single quoted literal values and double quoted values with escape sequences spanning multiple lines.
+func ParseDotEnv(r io.Reader) (map[string]string, error) {
+	res := map[string]string{}
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		key, value, found := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !found || !isValidEnvVarName(key) {
+			return nil, fmt.Errorf("line %d: invalid format, expected KEY=VALUE", lineNo)
+		}
+		value = strings.TrimSpace(value)
+
+		// Double quoted values may span multiple lines
+		startLine := lineNo
+		for strings.HasPrefix(value, `"`) && closingQuoteIndex(value, '"') < 0 {
+			if !scanner.Scan() {
+				return nil, fmt.Errorf("line %d: unterminated quoted value for %s", startLine, key)
+			}
+			lineNo++
+			value += "\n" + scanner.Text()
+		}
+
+		parsed, err := parseDotEnvValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", startLine, err)
+		}
+		res[key] = parsed
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read env file: %w", err)
+	}
+	return res, nil
+}
+
+// FormatDotEnv formats environment variables in .env syntax, sorted by name.
+// Values are quoted if needed, so the output can be read again by ParseDotEnv.
+func FormatDotEnv(vars map[string]string) string {
+	keys := make([]string, 0, len(vars))
+	for k := range vars {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		v := vars[k]
+		if strings.ContainsAny(v, " \t\r\n#\"'\\=$") {
+			v = `"` + dotEnvEscaper.Replace(v) + `"`
+		}
+		b.WriteString(k + "=" + v + "\n")
+	}
+	return b.String()
+}
+
+// parseDotEnvValue parses a value of a .env line, which may be quoted and followed by a comment.
+func parseDotEnvValue(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	quote := value[0]
+	if quote != '"' && quote != '\'' {
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = value[:i]
+		}
+		return strings.TrimSpace(value), nil
+	}
+
+	end := closingQuoteIndex(value, quote)
+	if end < 0 {
+		return "", errors.New("unterminated quoted value")
+	}
+	rest := strings.TrimSpace(value[end+1:])
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", fmt.Errorf("unexpected characters after quoted value: %s", rest)
+	}
+	return unquoteEnvVarValue(value[:end+1])
+}
+
+// unquoteEnvVarValue removes surrounding quotes of a value.
+// Double quoted values support escape sequences, single quoted values are taken literally.
+func unquoteEnvVarValue(value string) (string, error) {
+	if len(value) == 0 || (value[0] != '"' && value[0] != '\'') {
+		return value, nil
+	}
+	quote := value[0]
+	if len(value) < 2 || closingQuoteIndex(value, quote) != len(value)-1 {
+		return "", errors.New("unterminated quoted value")
+	}
+	inner := value[1 : len(value)-1]
+	if quote == '\'' {
+		return inner, nil
+	}
+	return dotEnvUnescaper.Replace(inner), nil
+}
+
+// closingQuoteIndex returns the index of the quote closing the quote at the start of s, or -1.
+func closingQuoteIndex(s string, quote byte) int {
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quote == '"':
+			i++
+		case s[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+func isValidEnvVarName(name string) bool {
+	return name != "" && !strings.ContainsAny(name, " \t\r\n=\"'")
+}
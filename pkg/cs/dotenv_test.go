@@ -0,0 +1,94 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs_test
+
+import (
+	"strings"
+
+	"github.com/codesphere-cloud/cs-go/pkg/cs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArgToEnvVarMap", func() {
+	It("parses values containing '='", func() {
+		res, err := cs.ArgToEnvVarMap([]string{"DSN=host=db user=app", "EMPTY="})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(map[string]string{"DSN": "host=db user=app", "EMPTY": ""}))
+	})
+
+	It("removes quotes", func() {
+		res, err := cs.ArgToEnvVarMap([]string{`A="hello \"world\"\n"`, `B='$literal \n'`})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(map[string]string{"A": "hello \"world\"\n", "B": `$literal \n`}))
+	})
+
+	It("fails on missing key", func() {
+		_, err := cs.ArgToEnvVarMap([]string{"=value"})
+		Expect(err).To(MatchError("invalid environment variable argument: =value"))
+	})
+
+	It("fails on unterminated quotes", func() {
+		_, err := cs.ArgToEnvVarMap([]string{`A="foo`})
+		Expect(err).To(MatchError(`invalid environment variable argument A="foo: unterminated quoted value`))
+	})
+})
+
+var _ = Describe("ParseDotEnv", func() {
+	It("parses .env syntax", func() {
+		input := strings.Join([]string{
+			"# a comment",
+			"",
+			"export FOO=bar",
+			"URL=https://example.com/?a=b # inline comment",
+			`QUOTED="hello # not a comment"`,
+			`SINGLE='$not expanded'`,
+			`MULTI="line1`,
+			`line2"`,
+			"  SPACED = value  ",
+		}, "\n")
+
+		res, err := cs.ParseDotEnv(strings.NewReader(input))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(map[string]string{
+			"FOO":    "bar",
+			"URL":    "https://example.com/?a=b",
+			"QUOTED": "hello # not a comment",
+			"SINGLE": "$not expanded",
+			"MULTI":  "line1\nline2",
+			"SPACED": "value",
+		}))
+	})
+
+	It("fails on invalid lines", func() {
+		_, err := cs.ParseDotEnv(strings.NewReader("FOO=bar\ninvalid\n"))
+		Expect(err).To(MatchError("line 2: invalid format, expected KEY=VALUE"))
+	})
+
+	It("fails on characters after quoted values", func() {
+		_, err := cs.ParseDotEnv(strings.NewReader("FOO='it''s'\n"))
+		Expect(err).To(MatchError("line 1: unexpected characters after quoted value: 's'"))
+	})
+
+	It("fails on unterminated quotes", func() {
+		_, err := cs.ParseDotEnv(strings.NewReader("FOO=\"bar\nBAZ=1\n"))
+		Expect(err).To(MatchError("line 1: unterminated quoted value for FOO"))
+	})
+})
+
+var _ = Describe("FormatDotEnv", func() {
+	It("formats sorted and quoted values that can be parsed again", func() {
+		vars := map[string]string{
+			"B": "plain",
+			"A": "with space and \"quotes\"\nand newline",
+			"C": "",
+		}
+		out := cs.FormatDotEnv(vars)
+		Expect(out).To(Equal("A=\"with space and \\\"quotes\\\"\\nand newline\"\nB=plain\nC=\n"))
+
+		res, err := cs.ParseDotEnv(strings.NewReader(out))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res).To(Equal(vars))
+	})
+})
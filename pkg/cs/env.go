@@ -6,23 +6,77 @@ package cs
 import (
 	"errors"
 	"fmt"
+	"log"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
 )
 
+// ErrNoApiToken is returned if no API token is configured.
+var ErrNoApiToken = errors.New("no API token found")
+
+// DefaultApiUrl is the API URL of Codesphere's SaaS offering, used if no other URL is configured.
+const DefaultApiUrl = "https://cloud.codesphere.com/api"
+
+// Environment resolves settings from environment variables,
+// falling back to the active context of the config file.
 type Environment struct {
+	configOnce  sync.Once
+	context     *Context
+	contextName string
 }
 
 func NewEnv() *Environment {
 	return &Environment{}
 }
 
+// activeContext lazily loads the active context of the config file, nil if there is none.
+func (e *Environment) activeContext() *Context {
+	e.configOnce.Do(func() {
+		path, err := ConfigPath()
+		if err != nil {
+			return
+		}
+		config, err := LoadConfig(path)
+		if err != nil {
+			log.Printf("Warning: ignoring config file: %s\n", err)
+			return
+		}
+		e.context = config.ActiveContext()
+		e.contextName = config.ActiveContextName()
+	})
+	return e.context
+}
+
 func (e *Environment) GetApiToken() (string, error) {
-	apiToken := os.Getenv("CS_TOKEN")
-	if apiToken == "" {
-		return "", errors.New("CS_TOKEN env var required, but not set")
+	token, _, err := e.LookupApiToken(e.GetApiUrl())
+	return token, err
+}
+
+// LookupApiToken returns the API token for apiUrl and a description of where it was found.
+// The token is read from CS_TOKEN, the active context or the credential store, in that order.
+// The token of the active context is only used if apiUrl is the API URL of the context.
+func (e *Environment) LookupApiToken(apiUrl string) (token string, source string, err error) {
+	if token := os.Getenv("CS_TOKEN"); token != "" {
+		return token, "CS_TOKEN environment variable", nil
+	}
+	if ctx := e.activeContext(); ctx != nil && ctx.Token != "" && sameApiUrl(contextApiUrl(ctx), apiUrl) {
+		return ctx.Token, fmt.Sprintf("context %s", e.contextName), nil
+	}
+
+	store, err := NewCredentialStore()
+	if err != nil {
+		return "", "", err
+	}
+	token, err = store.Get(apiUrl)
+	if errors.Is(err, ErrNoCredentials) {
+		return "", "", fmt.Errorf("%w for %s, set CS_TOKEN or run 'cs auth login'", ErrNoApiToken, apiUrl)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read stored credentials: %w", err)
 	}
-	return apiToken, nil
+	return token, "credential store", nil
 }
 
 func (e *Environment) GetWorkspaceId() (int, error) {
@@ -31,15 +85,38 @@ func (e *Environment) GetWorkspaceId() (int, error) {
 		return prefixedId, nil
 	}
 
-	return e.ReadNumericEnv("WORKSPACE_ID")
+	wsId, err := e.ReadNumericEnv("WORKSPACE_ID")
+	if wsId == -1 && err == nil {
+		return e.contextId(func(c *Context) int { return c.WorkspaceId }), nil
+	}
+	return wsId, err
 }
 
 func (e *Environment) GetTeamId() (int, error) {
-	return e.ReadNumericEnv("CS_TEAM_ID")
+	teamId, err := e.ReadNumericEnv("CS_TEAM_ID")
+	if teamId == -1 && err == nil {
+		return e.contextId(func(c *Context) int { return c.TeamId }), nil
+	}
+	return teamId, err
 }
 
 func (e *Environment) GetOrgId() string {
-	return os.Getenv("CS_ORG_ID")
+	orgId := os.Getenv("CS_ORG_ID")
+	if orgId == "" {
+		if ctx := e.activeContext(); ctx != nil {
+			return ctx.OrgId
+		}
+	}
+	return orgId
+}
+
+// contextId returns an ID of the active context or -1 if unset.
+func (e *Environment) contextId(get func(*Context) int) int {
+	ctx := e.activeContext()
+	if ctx == nil || get(ctx) <= 0 {
+		return -1
+	}
+	return get(ctx)
 }
 
 func (e *Environment) ReadNumericEnv(env string) (int, error) {
@@ -59,5 +136,21 @@ func (e *Environment) GetApiUrl() string {
 	if url != "" {
 		return url
 	}
-	return "https://cloud.codesphere.com/api"
+	if ctx := e.activeContext(); ctx != nil && ctx.ApiUrl != "" {
+		return ctx.ApiUrl
+	}
+	return DefaultApiUrl
+}
+
+// contextApiUrl returns the API URL of a context, the default URL if it has none.
+func contextApiUrl(ctx *Context) string {
+	if ctx.ApiUrl != "" {
+		return ctx.ApiUrl
+	}
+	return DefaultApiUrl
+}
+
+// sameApiUrl returns true if both URLs are equal, ignoring trailing slashes.
+func sameApiUrl(a string, b string) bool {
+	return strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
 }
@@ -0,0 +1,89 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs
+
+import (
+	"fmt"
+	"time"
+)
+
+type LogEntry struct {
+	Timestamp string `json:"timestamp"`
+	Kind      string `json:"kind"`
+	Data      string `json:"data"`
+}
+
+// LogSource describes where log entries come from.
+type LogSource struct {
+	WorkspaceId int
+	Stage       string
+	Step        int
+	Server      string
+	Replica     string
+}
+
+// String returns the stage and step of the source, and the server and replica if set, e.g. run/app/replica-1/step-0.
+func (s LogSource) String() string {
+	name := s.Stage
+	if s.Server != "" {
+		name += "/" + s.Server
+	}
+	if s.Replica != "" {
+		name += "/" + s.Replica
+	}
+	return fmt.Sprintf("%s/step-%d", name, s.Step)
+}
+
+// LogTarget is a log stream of a workspace.
+type LogTarget struct {
+	Source   LogSource
+	Endpoint string
+}
+
+// ParseLogTimestamp parses the timestamp of a log entry.
+func ParseLogTimestamp(ts string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, ts)
+}
+
+// CollectLogTargets returns the log streams of all steps of the stages, and for the run stage of every replica.
+// The token is used to get the pipeline status and has to be the one of apiUrl.
+func CollectLogTargets(apiUrl string, token string, wsId int, stages []string) ([]LogTarget, error) {
+	targets := []LogTarget{}
+	for _, stage := range stages {
+		status, err := GetPipelineStatus(apiUrl, token, wsId, stage)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pipeline status of stage %s: %w", stage, err)
+		}
+
+		if stage != "run" {
+			steps := 0
+			for _, s := range status {
+				steps = max(steps, len(s.Steps))
+			}
+			for step := range steps {
+				targets = append(targets, LogTarget{
+					Source:   LogSource{WorkspaceId: wsId, Stage: stage, Step: step},
+					Endpoint: fmt.Sprintf("%s/workspaces/%d/logs/%s/%d", apiUrl, wsId, stage, step),
+				})
+			}
+			continue
+		}
+
+		for _, replica := range status {
+			for step := range replica.Steps {
+				targets = append(targets, LogTarget{
+					Source: LogSource{
+						WorkspaceId: wsId,
+						Stage:       stage,
+						Step:        step,
+						Server:      replica.Server,
+						Replica:     replica.Replica,
+					},
+					Endpoint: fmt.Sprintf("%s/workspaces/%d/logs/run/%d/replica/%s", apiUrl, wsId, step, replica.Replica),
+				})
+			}
+		}
+	}
+	return targets, nil
+}
@@ -0,0 +1,266 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cs
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codesphere-cloud/cs-go/api/errors"
+)
+
+// DefaultLogsRetryDelay is the delay before reconnecting to a log stream unless the server sends a retry field.
+var DefaultLogsRetryDelay = 3 * time.Second
+
+// logsDedupSize is the number of recent log entries remembered to drop entries sent again after reconnecting.
+const logsDedupSize = 10000
+
+type SSE struct {
+	event string
+	data  string
+	id    string
+	// retry delay sent by the server, 0 if not sent
+	retry time.Duration
+}
+
+// LogStream reads log entries from a server-sent events endpoint.
+// In follow mode, it reconnects when the connection is closed or fails until the context is done.
+type LogStream struct {
+	endpoint string
+	// API token of the API the endpoint belongs to
+	token  string
+	follow bool
+
+	retryDelay  time.Duration
+	lastEventId string
+	// entries handled so far, to drop entries sent again after reconnecting
+	seen        *recentEntries
+	reconnected bool
+}
+
+// NewLogStream returns a stream of the log endpoint, authenticated with the token of its API.
+func NewLogStream(endpoint string, token string, follow bool) *LogStream {
+	s := &LogStream{
+		endpoint:   endpoint,
+		token:      token,
+		follow:     follow,
+		retryDelay: DefaultLogsRetryDelay,
+	}
+	if follow {
+		s.seen = newRecentEntries(logsDedupSize)
+	}
+	return s
+}
+
+// Run passes all log entries to handle, dropping entries already handled before reconnecting.
+// Returns nil when the stream ends without follow mode or when the context is done.
+func (s *LogStream) Run(ctx context.Context, handle func([]LogEntry)) error {
+	for {
+		err := s.read(ctx, handle)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !s.follow || !isReconnectable(err) {
+			return err
+		}
+
+		if err != nil {
+			slog.Warn("Log stream failed, reconnecting.", "error", err, "delay", s.retryDelay)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.retryDelay):
+		}
+		s.reconnected = true
+	}
+}
+
+// read handles the log entries of a single connection until it is closed.
+func (s *LogStream) read(ctx context.Context, handle func([]LogEntry)) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", s.endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to construct request: %s", err)
+	}
+
+	// Set the Accept header to indicate SSE
+	req.Header.Set("Accept", "text/event-stream")
+	if s.lastEventId != "" {
+		req.Header.Set("Last-Event-ID", s.lastEventId)
+	}
+	SetAuthoriziationHeader(req, s.token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return &streamError{fmt.Errorf("failed to request logs: %w", err)}
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		err := fmt.Errorf("log server responded with non-ok code: %d", resp.StatusCode)
+		if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+			return &streamError{err}
+		}
+		return err
+	}
+
+	reader := bufio.NewReader(resp.Body)
+	for {
+		sse, err := readSSE(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return &streamError{fmt.Errorf("failed to parse log: %s", err)}
+		}
+		if sse.retry > 0 {
+			s.retryDelay = sse.retry
+		}
+		if sse.id != "" {
+			s.lastEventId = sse.id
+		}
+		if sse.data == "" {
+			continue
+		}
+
+		var logEntries []LogEntry
+		err = json.Unmarshal([]byte(sse.data), &logEntries)
+		if err != nil {
+			var errRes errors.APIErrorResponse
+			err = json.Unmarshal([]byte(sse.data), &errRes)
+			if err != nil {
+				return fmt.Errorf("error reading error json: %w", err)
+			}
+			return &errors.APIError{
+				StatusCode: errRes.Status,
+				Title:      errRes.Title,
+				Detail:     errRes.Detail,
+				TraceId:    errRes.TraceId,
+			}
+		}
+
+		newEntries := make([]LogEntry, 0, len(logEntries))
+		for _, e := range logEntries {
+			// identical entries within the first connection are legit, e.g. a line logged twice
+			if s.seen == nil || s.seen.add(e) || !s.reconnected {
+				newEntries = append(newEntries, e)
+			}
+		}
+		if len(newEntries) > 0 {
+			handle(newEntries)
+		}
+	}
+}
+
+// readSSE reads the next event. Returns io.EOF if the stream ended before an event started.
+func readSSE(reader *bufio.Reader) (SSE, error) {
+	sse := SSE{}
+	started := false
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && started {
+				// unterminated last event is incomplete and dropped
+				return SSE{}, io.EOF
+			}
+			return SSE{}, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			// empty line marks end of SSE
+			if !started {
+				continue
+			}
+			return sse, nil
+		}
+		started = true
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimSpace(value)
+		switch field {
+		case "data":
+			if sse.data != "" {
+				sse.data += "\n" + value
+			} else {
+				sse.data = value
+			}
+		case "event":
+			if sse.event != "" {
+				slog.Warn(
+					"Received multiple event types in same SSE.",
+					"old", sse.event,
+					"new", value,
+				)
+			}
+			sse.event = value
+		case "id":
+			sse.id = value
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms >= 0 {
+				sse.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+}
+
+// streamError is a transient failure of a log stream, e.g. a network error.
+type streamError struct {
+	err error
+}
+
+func (e *streamError) Error() string {
+	return e.err.Error()
+}
+
+func (e *streamError) Unwrap() error {
+	return e.err
+}
+
+// isReconnectable returns true for closed connections and transient failures.
+func isReconnectable(err error) bool {
+	if err == nil {
+		return true
+	}
+	_, ok := err.(*streamError)
+	return ok
+}
+
+// recentEntries remembers a limited number of recently seen log entries.
+type recentEntries struct {
+	keys  map[LogEntry]struct{}
+	order []LogEntry
+	next  int
+}
+
+func newRecentEntries(size int) *recentEntries {
+	return &recentEntries{
+		keys:  make(map[LogEntry]struct{}, size),
+		order: make([]LogEntry, 0, size),
+	}
+}
+
+// add remembers the entry and returns false if it was seen already.
+func (r *recentEntries) add(e LogEntry) bool {
+	if _, ok := r.keys[e]; ok {
+		return false
+	}
+	if len(r.order) < cap(r.order) {
+		r.order = append(r.order, e)
+	} else {
+		delete(r.keys, r.order[r.next])
+		r.order[r.next] = e
+		r.next = (r.next + 1) % len(r.order)
+	}
+	r.keys[e] = struct{}{}
+	return true
+}
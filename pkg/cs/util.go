@@ -23,9 +23,10 @@ type ReplicaStatus struct {
 	Server  string `json:"server"`
 }
 
-func GetPipelineStatus(ws int, stage string) (res []ReplicaStatus, err error) {
+// GetPipelineStatus returns the status of a pipeline stage per replica, using the API at apiUrl authenticated with token.
+func GetPipelineStatus(apiUrl string, token string, ws int, stage string) (res []ReplicaStatus, err error) {
 
-	status, err := Get(fmt.Sprintf("workspaces/%d/pipeline/%s", ws, stage))
+	status, err := Get(apiUrl, token, fmt.Sprintf("workspaces/%d/pipeline/%s", ws, stage))
 	if err != nil {
 		err = fmt.Errorf("failed to get pipeline status: %w", err)
 		return
@@ -39,17 +40,14 @@ func GetPipelineStatus(ws int, stage string) (res []ReplicaStatus, err error) {
 	return
 }
 
-func Get(path string) (body []byte, err error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", NewEnv().GetApiUrl(), strings.TrimPrefix(path, "/")), http.NoBody)
+// Get requests path of the API at apiUrl, authenticated with token.
+func Get(apiUrl string, token string, path string) (body []byte, err error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", strings.TrimSuffix(apiUrl, "/"), strings.TrimPrefix(path, "/")), http.NoBody)
 	if err != nil {
 		err = fmt.Errorf("failed to create request: %w", err)
 		return
 	}
-	err = SetAuthoriziationHeader(req)
-	if err != nil {
-		err = fmt.Errorf("failed to set header: %w", err)
-		return
-	}
+	SetAuthoriziationHeader(req, token)
 
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -61,14 +59,10 @@ func Get(path string) (body []byte, err error) {
 	return
 }
 
-func SetAuthoriziationHeader(req *http.Request) error {
-	token, err := NewEnv().GetApiToken()
-	if err != nil {
-		return fmt.Errorf("failed to get API token: %w", err)
-	}
-
+// SetAuthoriziationHeader authenticates the request with the API token.
+// The token must be the one of the API URL the request is sent to, see [Environment.LookupApiToken].
+func SetAuthoriziationHeader(req *http.Request, token string) {
 	req.Header.Set("Authorization", "Bearer "+token)
-	return nil
 }
 
 type TeamRole int
@@ -93,19 +87,38 @@ func (r TeamRole) String() string {
 	}
 }
 
+// TeamRoleFromName returns the role for a name like "admin" or "member", case-insensitive.
+// Unknown names result in an invalid role, see [TeamRole.IsValid].
+func TeamRoleFromName(name string) TeamRole {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "admin":
+		return RoleAdmin
+	case "member":
+		return RoleMember
+	default:
+		return 0
+	}
+}
+
 func GetRoleName(role int) string {
 	return TeamRole(role).String()
 }
 
+// ArgToEnvVarMap parses arguments in form key=value into a map.
+// Values may contain '=' and can be quoted like in .env files, e.g. key="hello world".
 func ArgToEnvVarMap(input []string) (map[string]string, error) {
 	res := map[string]string{}
 	for _, v := range input {
-		split := strings.Split(v, "=")
-		if len(split) != 2 {
+		key, value, found := strings.Cut(v, "=")
+		key = strings.TrimSpace(key)
+		if !found || !isValidEnvVarName(key) {
 			return res, fmt.Errorf("invalid environment variable argument: %s", v)
-
 		}
-		res[split[0]] = split[1]
+		unquoted, err := unquoteEnvVarValue(value)
+		if err != nil {
+			return res, fmt.Errorf("invalid environment variable argument %s: %w", v, err)
+		}
+		res[key] = unquoted
 	}
 	return res, nil
 }
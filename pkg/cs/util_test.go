@@ -34,3 +34,14 @@ var _ = Describe("ValidateUrl", func() {
 	})
 
 })
+
+var _ = Describe("TeamRoleFromName", func() {
+	It("parses role names case-insensitive", func() {
+		Expect(cs.TeamRoleFromName("Admin")).To(Equal(cs.RoleAdmin))
+		Expect(cs.TeamRoleFromName(" member ")).To(Equal(cs.RoleMember))
+	})
+
+	It("returns an invalid role for unknown names", func() {
+		Expect(cs.TeamRoleFromName("owner").IsValid()).To(BeFalse())
+	})
+})
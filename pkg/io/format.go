@@ -0,0 +1,260 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package io
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// Output formats of lists, formats with an argument are given as <format>=<argument>.
+const (
+	FormatTable         = "table"
+	FormatJSON          = "json"
+	FormatYAML          = "yaml"
+	FormatCSV           = "csv"
+	FormatName          = "name"
+	FormatJSONPath      = "jsonpath"
+	FormatGoTemplate    = "go-template"
+	FormatCustomColumns = "custom-columns"
+)
+
+// ListFormats are the output formats supported by [PrintList].
+var ListFormats = []string{FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatName, FormatJSONPath + "=...", FormatGoTemplate + "=...", FormatCustomColumns + "=..."}
+
+// nameFields are the fields printed for each item with the name format, the first one present is used.
+var nameFields = []string{"name", "title", "email", "id"}
+
+// ValidateListFormat returns an error if the output format or its argument is invalid.
+func ValidateListFormat(format string) error {
+	name, arg, _ := strings.Cut(format, "=")
+	switch name {
+	case FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatName:
+		return nil
+	case FormatJSONPath:
+		_, err := parseJSONPath(arg)
+		return err
+	case FormatGoTemplate:
+		_, err := parseGoTemplate(arg)
+		return err
+	case FormatCustomColumns:
+		_, err := parseCustomColumns(arg)
+		return err
+	}
+	return fmt.Errorf("invalid output format: %s, must be one of %s", format, strings.Join(ListFormats, ", "))
+}
+
+// PrintList prints items in the output format.
+// The table and csv formats print the table returned by getTable, the other formats operate on the JSON representation of the items.
+// JSONPath expressions and Go templates are applied to an object with the items in the items field, e.g. {.items[*].id}.
+func PrintList(format string, items any, getTable func() table.Writer) error {
+	name, arg, _ := strings.Cut(format, "=")
+	switch name {
+	case FormatTable, "":
+		getTable().Render()
+		return nil
+	case FormatCSV:
+		getTable().RenderCSV()
+		return nil
+	case FormatJSON:
+		return PrintJSON(items)
+	case FormatYAML:
+		return PrintYAML(items)
+	case FormatName:
+		return PrintNames(items)
+	case FormatJSONPath:
+		return PrintJSONPath(items, arg)
+	case FormatGoTemplate:
+		return PrintGoTemplate(items, arg)
+	case FormatCustomColumns:
+		return PrintCustomColumns(items, arg)
+	}
+	return fmt.Errorf("invalid output format: %s, must be one of %s", format, strings.Join(ListFormats, ", "))
+}
+
+// PrintJSONPath prints the result of a kubectl-style JSONPath template, e.g. {.items[*].id}, for the items.
+func PrintJSONPath(items any, tmpl string) error {
+	j, err := parseJSONPath(tmpl)
+	if err != nil {
+		return err
+	}
+	data, err := itemsObject(items)
+	if err != nil {
+		return err
+	}
+	var out bytes.Buffer
+	if err := j.Execute(&out, data); err != nil {
+		return fmt.Errorf("failed to execute JSONPath %s: %w", tmpl, err)
+	}
+	fmt.Println(out.String())
+	return nil
+}
+
+// PrintGoTemplate prints the result of a Go template, e.g. {{range .items}}{{.id}}{{"\n"}}{{end}}, for the items.
+func PrintGoTemplate(items any, tmpl string) error {
+	t, err := parseGoTemplate(tmpl)
+	if err != nil {
+		return err
+	}
+	data, err := itemsObject(items)
+	if err != nil {
+		return err
+	}
+	if err := t.Execute(os.Stdout, data); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return nil
+}
+
+// PrintCustomColumns prints the items in columns given as HEADER:.field, comma separated, e.g. ID:.id,NAME:.name.
+func PrintCustomColumns(items any, spec string) error {
+	columns, err := parseCustomColumns(spec)
+	if err != nil {
+		return err
+	}
+	list, err := itemsList(items)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.header
+	}
+	_, _ = fmt.Fprintln(w, strings.Join(headers, "\t"))
+	for _, item := range list {
+		values := make([]string, len(columns))
+		for i, c := range columns {
+			results, err := c.path.FindResults(item)
+			if err != nil {
+				return fmt.Errorf("failed to get column %s: %w", c.header, err)
+			}
+			values[i] = formatColumnValue(results)
+		}
+		_, _ = fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	return w.Flush()
+}
+
+// PrintNames prints the name of each item, falling back to the title, email or ID for items without name.
+func PrintNames(items any) error {
+	list, err := itemsList(items)
+	if err != nil {
+		return err
+	}
+	for _, item := range list {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			fmt.Println(item)
+			continue
+		}
+		for _, field := range nameFields {
+			if v, ok := obj[field]; ok && v != nil {
+				fmt.Println(v)
+				break
+			}
+		}
+	}
+	return nil
+}
+
+type customColumn struct {
+	header string
+	path   *jsonpath.JSONPath
+}
+
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format requires columns, e.g. custom-columns=ID:.id,NAME:.name")
+	}
+	columns := []customColumn{}
+	for _, col := range strings.Split(spec, ",") {
+		header, expr, ok := strings.Cut(col, ":")
+		if !ok || header == "" || expr == "" {
+			return nil, fmt.Errorf("invalid custom column %q, must be HEADER:.field", col)
+		}
+		if !strings.HasPrefix(expr, "{") {
+			expr = "{" + expr + "}"
+		}
+		path, err := parseJSONPath(expr)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, customColumn{header: header, path: path})
+	}
+	return columns, nil
+}
+
+func parseJSONPath(tmpl string) (*jsonpath.JSONPath, error) {
+	if tmpl == "" {
+		return nil, fmt.Errorf("jsonpath format requires a template, e.g. jsonpath={.items[*].id}")
+	}
+	j := jsonpath.New("output").AllowMissingKeys(true)
+	if err := j.Parse(tmpl); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %s: %w", tmpl, err)
+	}
+	return j, nil
+}
+
+func parseGoTemplate(tmpl string) (*template.Template, error) {
+	if tmpl == "" {
+		return nil, fmt.Errorf("go-template format requires a template, e.g. go-template={{range .items}}{{.id}}{{\"\\n\"}}{{end}}")
+	}
+	t, err := template.New("output").Option("missingkey=zero").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return t, nil
+}
+
+func formatColumnValue(results [][]reflect.Value) string {
+	values := []string{}
+	for _, r := range results {
+		for _, v := range r {
+			if !v.IsValid() || (v.Kind() == reflect.Interface && v.IsNil()) {
+				continue
+			}
+			values = append(values, fmt.Sprint(v.Interface()))
+		}
+	}
+	if len(values) == 0 {
+		return "<none>"
+	}
+	return strings.Join(values, ",")
+}
+
+// itemsList converts items to their JSON representation.
+func itemsList(items any) ([]any, error) {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	// keep numbers as is, large IDs would be printed in exponent notation as float64
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var list []any
+	if err := dec.Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to convert items: %w", err)
+	}
+	return list, nil
+}
+
+// itemsObject converts items to the JSON representation of an object with the items in the items field.
+func itemsObject(items any) (map[string]any, error) {
+	list, err := itemsList(items)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"items": list}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package io_test
+
+import (
+	"bytes"
+	"io"
+	"os"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	csio "github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+type formatItem struct {
+	Id    int     `json:"id"`
+	Name  string  `json:"name"`
+	Email *string `json:"email,omitempty"`
+}
+
+// captureStdout returns what f prints to stdout.
+func captureStdout(f func() error) (string, error) {
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	err := f()
+	_ = w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	_, _ = io.Copy(&buf, r)
+	return buf.String(), err
+}
+
+var _ = Describe("PrintList", func() {
+	var items []formatItem
+
+	BeforeEach(func() {
+		email := "b@example.com"
+		items = []formatItem{
+			{Id: 1234567, Name: "a"},
+			{Id: 2, Name: "b", Email: &email},
+		}
+	})
+
+	getTable := func() table.Writer {
+		t := csio.GetTableWriter()
+		t.AppendHeader(table.Row{"ID", "Name"})
+		for _, i := range items {
+			t.AppendRow(table.Row{i.Id, i.Name})
+		}
+		return t
+	}
+
+	DescribeTable("prints items in the output format",
+		func(format string, expected string) {
+			out, err := captureStdout(func() error {
+				return csio.PrintList(format, items, getTable)
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(expected))
+		},
+		Entry("jsonpath", "jsonpath={.items[*].id}", "1234567 2\n"),
+		Entry("jsonpath with range", `jsonpath={range .items[*]}{.name}{"\n"}{end}`, "a\nb\n\n"),
+		Entry("go-template", `go-template={{range .items}}{{.id}}:{{.name}}{{"\n"}}{{end}}`, "1234567:a\n2:b\n"),
+		Entry("custom-columns", "custom-columns=ID:.id,EMAIL:.email", "ID        EMAIL\n1234567   <none>\n2         b@example.com\n"),
+		Entry("csv", "csv", "ID,Name\n1234567,a\n2,b\n"),
+		Entry("name", "name", "a\nb\n"),
+	)
+
+	It("prints tables", func() {
+		out, err := captureStdout(func() error {
+			return csio.PrintList("table", items, getTable)
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(ContainSubstring("| 1234567 | a    |"))
+	})
+
+	DescribeTable("validates formats",
+		func(format string, valid bool) {
+			err := csio.ValidateListFormat(format)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("table", "table", true),
+		Entry("csv", "csv", true),
+		Entry("jsonpath", "jsonpath={.items[0].id}", true),
+		Entry("jsonpath without template", "jsonpath", false),
+		Entry("invalid jsonpath", "jsonpath={.items[", false),
+		Entry("invalid go-template", "go-template={{.items", false),
+		Entry("custom-columns", "custom-columns=ID:.id", true),
+		Entry("custom-columns without expression", "custom-columns=ID", false),
+		Entry("unknown format", "xml", false),
+	)
+})
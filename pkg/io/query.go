@@ -0,0 +1,214 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package io
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// QueryJSON selects values from decoded JSON data with a subset of the jq syntax:
+//
+//	.             the input itself
+//	.key          field of an object, also ."key" or .["key"] for keys with special characters
+//	.[2], .[-1]   element of an array
+//	.[]           all elements of an array or all values of an object
+//	a | b         applies b to each result of a
+//
+// Accessing a field of null results in null, as in jq.
+func QueryJSON(data any, query string) ([]any, error) {
+	results := []any{data}
+	for _, stage := range splitQuery(query) {
+		steps, err := parseQueryPath(strings.TrimSpace(stage))
+		if err != nil {
+			return nil, fmt.Errorf("invalid query %q: %w", query, err)
+		}
+		for _, step := range steps {
+			next := []any{}
+			for _, v := range results {
+				res, err := step(v)
+				if err != nil {
+					return nil, err
+				}
+				next = append(next, res...)
+			}
+			results = next
+		}
+	}
+	return results, nil
+}
+
+type queryStep func(v any) ([]any, error)
+
+// splitQuery splits the query at pipes outside of quotes.
+func splitQuery(query string) []string {
+	stages := []string{}
+	quoted := false
+	start := 0
+	for i := 0; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case '|':
+			if !quoted {
+				stages = append(stages, query[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(stages, query[start:])
+}
+
+func parseQueryPath(path string) ([]queryStep, error) {
+	if !strings.HasPrefix(path, ".") {
+		return nil, fmt.Errorf("path %q must start with '.'", path)
+	}
+	steps := []queryStep{}
+	for i := 0; i < len(path); {
+		switch {
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("missing ']' in %q", path)
+			}
+			step, err := parseQueryBracket(path[i+1 : i+end])
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, step)
+			i += end + 1
+		case path[i] == '.':
+			i++
+			if i == len(path) || path[i] == '[' {
+				continue
+			}
+			if path[i] == '"' {
+				key, n, err := unquoteKey(path[i:])
+				if err != nil {
+					return nil, err
+				}
+				steps = append(steps, fieldStep(key))
+				i += n
+				continue
+			}
+			end := i
+			for end < len(path) && path[end] != '.' && path[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("missing key after '.' in %q", path)
+			}
+			steps = append(steps, fieldStep(path[i:end]))
+			i = end
+		default:
+			return nil, fmt.Errorf("unexpected %q in %q", path[i], path)
+		}
+	}
+	return steps, nil
+}
+
+func parseQueryBracket(content string) (queryStep, error) {
+	content = strings.TrimSpace(content)
+	if content == "" {
+		return iterateStep, nil
+	}
+	if strings.HasPrefix(content, "\"") {
+		key, n, err := unquoteKey(content)
+		if err != nil {
+			return nil, err
+		}
+		if n != len(content) {
+			return nil, fmt.Errorf("unexpected %q after key", content[n:])
+		}
+		return fieldStep(key), nil
+	}
+	index, err := strconv.Atoi(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index %q", content)
+	}
+	return indexStep(index), nil
+}
+
+// unquoteKey returns the quoted key at the start of s and the length of the quoted key.
+func unquoteKey(s string) (string, int, error) {
+	prefix, err := strconv.QuotedPrefix(s)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid quoted key in %q", s)
+	}
+	key, err := strconv.Unquote(prefix)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid quoted key in %q", s)
+	}
+	return key, len(prefix), nil
+}
+
+func fieldStep(key string) queryStep {
+	return func(v any) ([]any, error) {
+		switch obj := v.(type) {
+		case nil:
+			return []any{nil}, nil
+		case map[string]any:
+			return []any{obj[key]}, nil
+		default:
+			return nil, fmt.Errorf("cannot get field %q of %s", key, jsonType(v))
+		}
+	}
+}
+
+func indexStep(index int) queryStep {
+	return func(v any) ([]any, error) {
+		switch arr := v.(type) {
+		case nil:
+			return []any{nil}, nil
+		case []any:
+			i := index
+			if i < 0 {
+				i += len(arr)
+			}
+			if i < 0 || i >= len(arr) {
+				return []any{nil}, nil
+			}
+			return []any{arr[i]}, nil
+		default:
+			return nil, fmt.Errorf("cannot get index %d of %s", index, jsonType(v))
+		}
+	}
+}
+
+func iterateStep(v any) ([]any, error) {
+	switch val := v.(type) {
+	case []any:
+		return val, nil
+	case map[string]any:
+		res := make([]any, 0, len(val))
+		for _, k := range slices.Sorted(maps.Keys(val)) {
+			res = append(res, val[k])
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("cannot iterate over %s", jsonType(v))
+	}
+}
+
+func jsonType(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	default:
+		return "number"
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) Codesphere Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package io_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	csio "github.com/codesphere-cloud/cs-go/pkg/io"
+)
+
+var _ = Describe("QueryJSON", func() {
+	var data any
+
+	BeforeEach(func() {
+		err := json.Unmarshal([]byte(`{
+			"teams": [{"id": 1, "name": "a"}, {"id": 2, "name": "b"}],
+			"my-key": {"x": true}
+		}`), &data)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	DescribeTable("selects values",
+		func(query string, expected []any) {
+			res, err := csio.QueryJSON(data, query)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res).To(Equal(expected))
+		},
+		Entry("field", ".teams[0].name", []any{"a"}),
+		Entry("negative index", ".teams[-1].id", []any{float64(2)}),
+		Entry("iteration", ".teams[].name", []any{"a", "b"}),
+		Entry("quoted key", `."my-key".x`, []any{true}),
+		Entry("bracket key", `.["my-key"]["x"]`, []any{true}),
+		Entry("pipe", ".teams[] | .id", []any{float64(1), float64(2)}),
+		Entry("missing field", ".missing.field", []any{nil}),
+		Entry("index out of range", ".teams[5]", []any{nil}),
+	)
+
+	It("returns the input for .", func() {
+		Expect(csio.QueryJSON(data, ".")).To(Equal([]any{data}))
+	})
+
+	DescribeTable("rejects invalid queries",
+		func(query string) {
+			_, err := csio.QueryJSON(data, query)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("missing dot", "teams"),
+		Entry("unclosed bracket", ".teams[0"),
+		Entry("invalid index", ".teams[a]"),
+		Entry("field of array", ".teams.name"),
+		Entry("iterate string", ".teams[0].name[]"),
+	)
+})